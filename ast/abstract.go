@@ -0,0 +1,21 @@
+package ast
+
+import "ghostlang.org/x/ghost/token"
+
+type Abstract struct {
+	StatementNode
+	Token      token.Token // The "abstract" token
+	Name       *Identifier
+	Parameters []*Identifier
+	Closing    token.Token // The closing ")" token
+}
+
+// Span returns the part of the source the abstract method was parsed from.
+func (abstract *Abstract) Span() Span {
+	return between(tokenSpan(abstract.Token), tokenSpan(abstract.Closing))
+}
+
+// String represents the abstract method as source code.
+func (abstract *Abstract) String() string {
+	return "abstract function " + abstract.Name.Value + "(" + joinIdentifiers(abstract.Parameters) + ")"
+}
@@ -0,0 +1,20 @@
+package ast
+
+import "ghostlang.org/x/ghost/token"
+
+type Accessor struct {
+	StatementNode
+	Token    token.Token // The "get" or "set" token
+	Setter   bool        // A setter rather than a getter
+	Function *Function
+}
+
+// Span returns the part of the source the accessor was parsed from.
+func (accessor *Accessor) Span() Span {
+	return between(tokenSpan(accessor.Token), accessor.Function.Span())
+}
+
+// String represents the accessor as source code.
+func (accessor *Accessor) String() string {
+	return accessor.Token.Lexeme + " " + accessor.Function.Signature() + " " + accessor.Function.Body.String()
+}
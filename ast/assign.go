@@ -8,3 +8,13 @@ type Assign struct {
 	Name  AssignmentNode
 	Value ExpressionNode
 }
+
+// Span returns the part of the source the assignment was parsed from.
+func (assign *Assign) Span() Span {
+	return between(assign.Name.Span(), assign.Value.Span())
+}
+
+// String represents the assignment as source code.
+func (assign *Assign) String() string {
+	return stringOf(assign.Name) + " = " + stringOf(assign.Value)
+}
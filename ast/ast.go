@@ -1,6 +1,18 @@
 package ast
 
-type Node interface{}
+import (
+	"strings"
+
+	"ghostlang.org/x/ghost/token"
+)
+
+// Node is implemented by every node of the syntax tree. Span reports where in
+// the source the node begins and ends, and String renders the node back as
+// source code.
+type Node interface {
+	Span() Span
+	String() string
+}
 
 type StatementNode interface {
 	Node
@@ -13,3 +25,67 @@ type ExpressionNode interface {
 type AssignmentNode interface {
 	Node
 }
+
+// Position is a line and column of a source file, both counted from 1.
+type Position struct {
+	Line   int
+	Column int
+}
+
+// Span is the part of a source file a node was parsed from. End is the
+// position just past the node's last character.
+type Span struct {
+	Start Position
+	End   Position
+}
+
+// tokenSpan returns the span of a single token.
+func tokenSpan(tok token.Token) Span {
+	return Span{
+		Start: Position{Line: tok.Line, Column: tok.Column},
+		End:   Position{Line: tok.EndLine, Column: tok.EndColumn},
+	}
+}
+
+// between returns the span from the start of the first span to the end of the
+// last one.
+func between(first Span, last Span) Span {
+	return Span{Start: first.Start, End: last.End}
+}
+
+// hasToken reports whether a token was read from the source, rather than left
+// unset on a node the parser created itself.
+func hasToken(tok token.Token) bool {
+	return tok.Line > 0
+}
+
+// stringOf renders a node, or nothing if the node is missing.
+func stringOf(node Node) string {
+	if isNil(node) {
+		return ""
+	}
+
+	return node.String()
+}
+
+// joinExpressions renders a list of expressions separated by commas.
+func joinExpressions(expressions []ExpressionNode) string {
+	parts := make([]string, len(expressions))
+
+	for index, expression := range expressions {
+		parts[index] = stringOf(expression)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// joinIdentifiers renders a list of identifiers separated by commas.
+func joinIdentifiers(identifiers []*Identifier) string {
+	parts := make([]string, len(identifiers))
+
+	for index, identifier := range identifiers {
+		parts[index] = identifier.Value
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -1,9 +1,45 @@
 package ast
 
-import "ghostlang.org/x/ghost/token"
+import (
+	"strings"
+
+	"ghostlang.org/x/ghost/token"
+)
 
 type Block struct {
 	StatementNode
 	Token      token.Token
 	Statements []StatementNode
+	Closing    token.Token // The closing "}" token
+}
+
+// Span returns the part of the source the block was parsed from. Blocks the
+// parser creates itself, such as the body of an arrow function returning an
+// expression, span their statements.
+func (block *Block) Span() Span {
+	if hasToken(block.Token) && hasToken(block.Closing) {
+		return between(tokenSpan(block.Token), tokenSpan(block.Closing))
+	}
+
+	if len(block.Statements) == 0 {
+		return tokenSpan(block.Token)
+	}
+
+	return between(block.Statements[0].Span(), block.Statements[len(block.Statements)-1].Span())
+}
+
+// String represents the block as source code, with its statements separated
+// by semicolons.
+func (block *Block) String() string {
+	if len(block.Statements) == 0 {
+		return "{}"
+	}
+
+	statements := make([]string, len(block.Statements))
+
+	for index, statement := range block.Statements {
+		statements[index] = stringOf(statement)
+	}
+
+	return "{ " + strings.Join(statements, "; ") + " }"
 }
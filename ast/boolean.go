@@ -7,3 +7,13 @@ type Boolean struct {
 	Token token.Token
 	Value bool
 }
+
+// Span returns the part of the source the boolean was parsed from.
+func (boolean *Boolean) Span() Span {
+	return tokenSpan(boolean.Token)
+}
+
+// String represents the boolean as source code.
+func (boolean *Boolean) String() string {
+	return boolean.Token.Lexeme
+}
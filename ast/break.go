@@ -6,3 +6,13 @@ type Break struct {
 	ExpressionNode
 	Token token.Token
 }
+
+// Span returns the part of the source the break statement was parsed from.
+func (breakStatement *Break) Span() Span {
+	return tokenSpan(breakStatement.Token)
+}
+
+// String represents the break statement as source code.
+func (breakStatement *Break) String() string {
+	return "break"
+}
@@ -1,10 +1,42 @@
 package ast
 
-import "ghostlang.org/x/ghost/token"
+import (
+	"strings"
+
+	"ghostlang.org/x/ghost/token"
+)
 
 type Call struct {
 	ExpressionNode
 	Token     token.Token
 	Callee    ExpressionNode
 	Arguments []ExpressionNode
+	Named     []*NamedArgument
+	Closing   token.Token // The closing ")" token
+}
+
+// Span returns the part of the source the call was parsed from.
+func (call *Call) Span() Span {
+	return between(call.Callee.Span(), tokenSpan(call.Closing))
+}
+
+// String represents the call as source code.
+func (call *Call) String() string {
+	return stringOf(call.Callee) + "(" + joinArguments(call.Arguments, call.Named) + ")"
+}
+
+// joinArguments renders the positional and named arguments of a call
+// separated by commas.
+func joinArguments(arguments []ExpressionNode, named []*NamedArgument) string {
+	parts := []string{}
+
+	if len(arguments) > 0 {
+		parts = append(parts, joinExpressions(arguments))
+	}
+
+	for _, argument := range named {
+		parts = append(parts, argument.String())
+	}
+
+	return strings.Join(parts, ", ")
 }
@@ -11,3 +11,17 @@ type Case struct {
 	Value   []ExpressionNode // The value of the case we'll be matching against
 	Body    *Block           // The block that will be evaluated if matched
 }
+
+// Span returns the part of the source the switch case was parsed from.
+func (switchCase *Case) Span() Span {
+	return between(tokenSpan(switchCase.Token), switchCase.Body.Span())
+}
+
+// String represents the switch case as source code.
+func (switchCase *Case) String() string {
+	if switchCase.Default {
+		return "default " + switchCase.Body.String()
+	}
+
+	return "case " + joinExpressions(switchCase.Value) + " " + switchCase.Body.String()
+}
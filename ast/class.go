@@ -1,11 +1,47 @@
 package ast
 
-import "ghostlang.org/x/ghost/token"
+import (
+	"strings"
+
+	"ghostlang.org/x/ghost/token"
+)
 
 type Class struct {
 	ExpressionNode
-	Token token.Token
-	Name  *Identifier
-	Super *Identifier
-	Body  *Block
+	Token      token.Token
+	Name       *Identifier
+	Super      *Identifier
+	Body       *Block
+	Decorators []*Decorator
+}
+
+// Span returns the part of the source the class was parsed from, including
+// its decorators.
+func (class *Class) Span() Span {
+	start := tokenSpan(class.Token)
+
+	if len(class.Decorators) > 0 {
+		start = class.Decorators[0].Span()
+	}
+
+	return between(start, class.Body.Span())
+}
+
+// String represents the class as source code.
+func (class *Class) String() string {
+	var out strings.Builder
+
+	for _, decorator := range class.Decorators {
+		out.WriteString(decorator.String() + " ")
+	}
+
+	out.WriteString("class " + class.Name.Value + " ")
+
+	if class.Super != nil {
+		out.WriteString("extends " + class.Super.Value + " ")
+	}
+
+	out.WriteString(class.Body.String())
+
+	return out.String()
 }
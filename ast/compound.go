@@ -9,3 +9,14 @@ type Compound struct {
 	Operator string
 	Right    ExpressionNode
 }
+
+// Span returns the part of the source the compound assignment was parsed
+// from.
+func (compound *Compound) Span() Span {
+	return between(compound.Left.Span(), compound.Right.Span())
+}
+
+// String represents the compound assignment as source code.
+func (compound *Compound) String() string {
+	return stringOf(compound.Left) + " " + compound.Operator + " " + stringOf(compound.Right)
+}
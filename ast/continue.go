@@ -6,3 +6,13 @@ type Continue struct {
 	ExpressionNode
 	Token token.Token
 }
+
+// Span returns the part of the source the continue statement was parsed from.
+func (continueStatement *Continue) Span() Span {
+	return tokenSpan(continueStatement.Token)
+}
+
+// String represents the continue statement as source code.
+func (continueStatement *Continue) String() string {
+	return "continue"
+}
@@ -0,0 +1,31 @@
+package ast
+
+import "ghostlang.org/x/ghost/token"
+
+type Declaration struct {
+	StatementNode
+	Token    token.Token    // The "let" or "const" token
+	Name     AssignmentNode // The identifier or destructuring pattern declared
+	Value    ExpressionNode
+	Constant bool
+}
+
+// Span returns the part of the source the declaration was parsed from.
+func (declaration *Declaration) Span() Span {
+	if declaration.Value == nil {
+		return between(tokenSpan(declaration.Token), declaration.Name.Span())
+	}
+
+	return between(tokenSpan(declaration.Token), declaration.Value.Span())
+}
+
+// String represents the declaration as source code.
+func (declaration *Declaration) String() string {
+	out := declaration.Token.Lexeme + " " + stringOf(declaration.Name)
+
+	if declaration.Value != nil {
+		out += " = " + stringOf(declaration.Value)
+	}
+
+	return out
+}
@@ -0,0 +1,21 @@
+package ast
+
+import "ghostlang.org/x/ghost/token"
+
+// Decorator wraps the function or class declaration that follows it, as in
+// @memoize or @route("/users").
+type Decorator struct {
+	ExpressionNode
+	Token      token.Token // The "@" token
+	Expression ExpressionNode
+}
+
+// Span returns the part of the source the decorator was parsed from.
+func (decorator *Decorator) Span() Span {
+	return between(tokenSpan(decorator.Token), decorator.Expression.Span())
+}
+
+// String represents the decorator as source code.
+func (decorator *Decorator) String() string {
+	return "@" + stringOf(decorator.Expression)
+}
@@ -0,0 +1,31 @@
+package ast
+
+import (
+	"strings"
+
+	"ghostlang.org/x/ghost/token"
+)
+
+type Enum struct {
+	ExpressionNode
+	Token   token.Token // The "enum" token
+	Name    *Identifier
+	Members []*EnumMember // The members in declaration order
+	Closing token.Token   // The closing "}" token
+}
+
+// Span returns the part of the source the enum was parsed from.
+func (enum *Enum) Span() Span {
+	return between(tokenSpan(enum.Token), tokenSpan(enum.Closing))
+}
+
+// String represents the enum as source code.
+func (enum *Enum) String() string {
+	members := make([]string, len(enum.Members))
+
+	for index, member := range enum.Members {
+		members[index] = member.String()
+	}
+
+	return "enum " + enum.Name.Value + " { " + strings.Join(members, ", ") + " }"
+}
@@ -0,0 +1,29 @@
+package ast
+
+import "ghostlang.org/x/ghost/token"
+
+type EnumMember struct {
+	ExpressionNode
+	Token   token.Token
+	Name    *Identifier
+	Values  []*NamedArgument // The member's associated values, if any
+	Closing token.Token      // The ")" closing the associated values, if any
+}
+
+// Span returns the part of the source the enum member was parsed from.
+func (member *EnumMember) Span() Span {
+	if hasToken(member.Closing) {
+		return between(tokenSpan(member.Token), tokenSpan(member.Closing))
+	}
+
+	return tokenSpan(member.Token)
+}
+
+// String represents the enum member as source code.
+func (member *EnumMember) String() string {
+	if !hasToken(member.Closing) {
+		return member.Name.Value
+	}
+
+	return member.Name.Value + "(" + joinArguments(nil, member.Values) + ")"
+}
@@ -4,3 +4,14 @@ type Expression struct {
 	StatementNode
 	Expression ExpressionNode
 }
+
+// Span returns the part of the source the expression statement was parsed
+// from.
+func (expression *Expression) Span() Span {
+	return expression.Expression.Span()
+}
+
+// String represents the expression statement as source code.
+func (expression *Expression) String() string {
+	return stringOf(expression.Expression)
+}
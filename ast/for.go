@@ -11,3 +11,13 @@ type For struct {
 	Increment   StatementNode
 	Block       *Block
 }
+
+// Span returns the part of the source the for loop was parsed from.
+func (forExpression *For) Span() Span {
+	return between(tokenSpan(forExpression.Token), forExpression.Block.Span())
+}
+
+// String represents the for loop as source code.
+func (forExpression *For) String() string {
+	return "for (" + stringOf(forExpression.Initializer) + "; " + stringOf(forExpression.Condition) + "; " + stringOf(forExpression.Increment) + ") " + forExpression.Block.String()
+}
@@ -6,7 +6,24 @@ type ForIn struct {
 	ExpressionNode
 	Token    token.Token    // for
 	Key      *Identifier    // key
-	Value    *Identifier    // value
+	Value    AssignmentNode // value, or a destructuring pattern
 	Iterable ExpressionNode // list, map
 	Block    *Block         // { ... }
 }
+
+// Span returns the part of the source the for in loop was parsed from.
+func (forIn *ForIn) Span() Span {
+	return between(tokenSpan(forIn.Token), forIn.Block.Span())
+}
+
+// String represents the for in loop as source code. Loops without a key
+// have an empty key identifier.
+func (forIn *ForIn) String() string {
+	target := stringOf(forIn.Value)
+
+	if forIn.Key != nil && forIn.Key.Value != "" {
+		target = forIn.Key.Value + ", " + target
+	}
+
+	return "for (" + target + " in " + stringOf(forIn.Iterable) + ") " + forIn.Block.String()
+}
@@ -1,6 +1,8 @@
 package ast
 
 import (
+	"strings"
+
 	"ghostlang.org/x/ghost/token"
 )
 
@@ -10,5 +12,89 @@ type Function struct {
 	Name       *Identifier
 	Parameters []*Identifier
 	Defaults   map[string]ExpressionNode
+	Rest       *Identifier
 	Body       *Block
+	Arrow      bool
+	Generator  bool // The body contains a yield expression
+	Decorators []*Decorator
+	Opening    token.Token // The "(" opening the parameters of an arrow function
+}
+
+// Span returns the part of the source the function was parsed from,
+// including its decorators.
+func (function *Function) Span() Span {
+	start := tokenSpan(function.Token)
+
+	switch {
+	case len(function.Decorators) > 0:
+		start = function.Decorators[0].Span()
+	case function.Arrow && hasToken(function.Opening):
+		start = tokenSpan(function.Opening)
+	case function.Arrow && len(function.Parameters) > 0:
+		start = function.Parameters[0].Span()
+	}
+
+	return between(start, function.Body.Span())
+}
+
+// String represents the function as source code. Arrow functions returning
+// an expression are written without a block.
+func (function *Function) String() string {
+	var out strings.Builder
+
+	for _, decorator := range function.Decorators {
+		out.WriteString(decorator.String() + " ")
+	}
+
+	if function.Arrow {
+		out.WriteString("(" + function.parameters() + ") => ")
+
+		if function.Body.Token.Type == token.ARROW && len(function.Body.Statements) == 1 {
+			if returned, ok := function.Body.Statements[0].(*Return); ok {
+				out.WriteString(stringOf(returned.Value))
+
+				return out.String()
+			}
+		}
+
+		out.WriteString(function.Body.String())
+
+		return out.String()
+	}
+
+	out.WriteString("function " + function.Signature() + " " + function.Body.String())
+
+	return out.String()
+}
+
+// Signature represents the function's name, if any, and its parameters as
+// source code, such as greet(name, greeting = "hello").
+func (function *Function) Signature() string {
+	name := ""
+
+	if function.Name != nil {
+		name = function.Name.Value
+	}
+
+	return name + "(" + function.parameters() + ")"
+}
+
+// parameters renders the function's parameters with their default values and
+// its rest parameter.
+func (function *Function) parameters() string {
+	parameters := []string{}
+
+	for _, parameter := range function.Parameters {
+		if value, ok := function.Defaults[parameter.Value]; ok {
+			parameters = append(parameters, parameter.Value+" = "+stringOf(value))
+		} else {
+			parameters = append(parameters, parameter.Value)
+		}
+	}
+
+	if function.Rest != nil {
+		parameters = append(parameters, "..."+function.Rest.Value)
+	}
+
+	return strings.Join(parameters, ", ")
 }
@@ -8,3 +8,13 @@ type Identifier struct {
 	Token token.Token
 	Value string
 }
+
+// Span returns the part of the source the identifier was parsed from.
+func (identifier *Identifier) Span() Span {
+	return tokenSpan(identifier.Token)
+}
+
+// String represents the identifier as source code.
+func (identifier *Identifier) String() string {
+	return identifier.Value
+}
@@ -9,3 +9,28 @@ type If struct {
 	Consequence *Block
 	Alternative *Block
 }
+
+// Span returns the part of the source the if expression was parsed from.
+func (ifExpression *If) Span() Span {
+	if ifExpression.Alternative != nil {
+		return between(tokenSpan(ifExpression.Token), ifExpression.Alternative.Span())
+	}
+
+	return between(tokenSpan(ifExpression.Token), ifExpression.Consequence.Span())
+}
+
+// String represents the if expression as source code. An else if branch is
+// kept as an alternative block that only contains the next if expression.
+func (ifExpression *If) String() string {
+	out := "if (" + stringOf(ifExpression.Condition) + ") " + ifExpression.Consequence.String()
+
+	if ifExpression.Alternative == nil {
+		return out
+	}
+
+	if !hasToken(ifExpression.Alternative.Token) && len(ifExpression.Alternative.Statements) == 1 {
+		return out + " else " + stringOf(ifExpression.Alternative.Statements[0])
+	}
+
+	return out + " else " + ifExpression.Alternative.String()
+}
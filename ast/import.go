@@ -7,3 +7,13 @@ type Import struct {
 	Token token.Token
 	Path  *String
 }
+
+// Span returns the part of the source the import was parsed from.
+func (importStatement *Import) Span() Span {
+	return between(tokenSpan(importStatement.Token), importStatement.Path.Span())
+}
+
+// String represents the import as source code.
+func (importStatement *Import) String() string {
+	return "import " + importStatement.Path.String()
+}
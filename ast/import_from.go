@@ -1,6 +1,10 @@
 package ast
 
-import "ghostlang.org/x/ghost/token"
+import (
+	"strings"
+
+	"ghostlang.org/x/ghost/token"
+)
 
 type ImportFrom struct {
 	ExpressionNode
@@ -8,4 +12,29 @@ type ImportFrom struct {
 	Path        *String
 	Identifiers map[string]*Identifier
 	Everything  bool
+	Aliases     []string // The aliases in source order
+}
+
+// Span returns the part of the source the import was parsed from.
+func (importFrom *ImportFrom) Span() Span {
+	return between(tokenSpan(importFrom.Token), importFrom.Path.Span())
+}
+
+// String represents the import as source code.
+func (importFrom *ImportFrom) String() string {
+	if importFrom.Everything {
+		return "import * from " + importFrom.Path.String()
+	}
+
+	names := make([]string, len(importFrom.Aliases))
+
+	for index, alias := range importFrom.Aliases {
+		names[index] = importFrom.Identifiers[alias].Value
+
+		if alias != names[index] {
+			names[index] += " as " + alias
+		}
+	}
+
+	return "import " + strings.Join(names, ", ") + " from " + importFrom.Path.String()
 }
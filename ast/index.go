@@ -5,7 +5,23 @@ import "ghostlang.org/x/ghost/token"
 type Index struct {
 	ExpressionNode
 	AssignmentNode
-	Token token.Token
-	Left  ExpressionNode
-	Index ExpressionNode
+	Token    token.Token
+	Left     ExpressionNode
+	Index    ExpressionNode
+	Optional bool        // Accessed with "?."
+	Closing  token.Token // The closing "]" token
+}
+
+// Span returns the part of the source the index expression was parsed from.
+func (index *Index) Span() Span {
+	return between(index.Left.Span(), tokenSpan(index.Closing))
+}
+
+// String represents the index expression as source code.
+func (index *Index) String() string {
+	if index.Optional {
+		return stringOf(index.Left) + "?.[" + stringOf(index.Index) + "]"
+	}
+
+	return stringOf(index.Left) + "[" + stringOf(index.Index) + "]"
 }
@@ -9,3 +9,14 @@ type Infix struct {
 	Operator string
 	Right    ExpressionNode
 }
+
+// Span returns the part of the source the infix expression was parsed from.
+func (infix *Infix) Span() Span {
+	return between(infix.Left.Span(), infix.Right.Span())
+}
+
+// String represents the infix expression as source code, parenthesized so
+// it reads the same regardless of where it is used.
+func (infix *Infix) String() string {
+	return "(" + stringOf(infix.Left) + " " + infix.Operator + " " + stringOf(infix.Right) + ")"
+}
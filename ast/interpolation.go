@@ -0,0 +1,38 @@
+package ast
+
+import (
+	"strings"
+
+	"ghostlang.org/x/ghost/token"
+)
+
+type Interpolation struct {
+	ExpressionNode
+	Token token.Token      // The interpolated string token
+	Parts []ExpressionNode // The literal strings and expressions to be joined
+}
+
+// Span returns the part of the source the interpolated string was parsed
+// from.
+func (interpolation *Interpolation) Span() Span {
+	return tokenSpan(interpolation.Token)
+}
+
+// String represents the interpolated string as source code.
+func (interpolation *Interpolation) String() string {
+	var out strings.Builder
+
+	out.WriteString(`"`)
+
+	for _, part := range interpolation.Parts {
+		if text, ok := part.(*String); ok {
+			out.WriteString(escapeInterpolation(text.Value))
+		} else {
+			out.WriteString("${" + stringOf(part) + "}")
+		}
+	}
+
+	out.WriteString(`"`)
+
+	return out.String()
+}
@@ -6,4 +6,15 @@ type List struct {
 	ExpressionNode
 	Token    token.Token
 	Elements []ExpressionNode
+	Closing  token.Token // The closing "]" token
+}
+
+// Span returns the part of the source the list was parsed from.
+func (list *List) Span() Span {
+	return between(tokenSpan(list.Token), tokenSpan(list.Closing))
+}
+
+// String represents the list as source code.
+func (list *List) String() string {
+	return "[" + joinExpressions(list.Elements) + "]"
 }
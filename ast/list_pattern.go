@@ -0,0 +1,35 @@
+package ast
+
+import (
+	"strings"
+
+	"ghostlang.org/x/ghost/token"
+)
+
+type ListPattern struct {
+	AssignmentNode
+	Token    token.Token      // The "[" token
+	Elements []AssignmentNode // The targets each element is assigned to
+	Rest     AssignmentNode   // The target the remaining elements are assigned to
+	Closing  token.Token      // The closing "]" token
+}
+
+// Span returns the part of the source the list pattern was parsed from.
+func (pattern *ListPattern) Span() Span {
+	return between(tokenSpan(pattern.Token), tokenSpan(pattern.Closing))
+}
+
+// String represents the list pattern as source code.
+func (pattern *ListPattern) String() string {
+	elements := []string{}
+
+	for _, element := range pattern.Elements {
+		elements = append(elements, stringOf(element))
+	}
+
+	if pattern.Rest != nil {
+		elements = append(elements, "..."+stringOf(pattern.Rest))
+	}
+
+	return "[" + strings.Join(elements, ", ") + "]"
+}
@@ -1,9 +1,55 @@
 package ast
 
-import "ghostlang.org/x/ghost/token"
+import (
+	"strings"
+
+	"ghostlang.org/x/ghost/token"
+)
 
 type Map struct {
 	ExpressionNode
-	Token token.Token
-	Pairs map[ExpressionNode]ExpressionNode
+	Token   token.Token
+	Pairs   map[ExpressionNode]ExpressionNode
+	Keys    []ExpressionNode // The keys in source order, including spread expressions
+	Closing token.Token      // The closing "}" token
+}
+
+// Span returns the part of the source the map was parsed from.
+func (mapLiteral *Map) Span() Span {
+	return between(tokenSpan(mapLiteral.Token), tokenSpan(mapLiteral.Closing))
+}
+
+// String represents the map as source code, with its pairs in source order.
+func (mapLiteral *Map) String() string {
+	pairs := make([]string, len(mapLiteral.Keys))
+
+	for index, key := range mapLiteral.Keys {
+		value, ok := mapLiteral.Pairs[key]
+
+		switch {
+		case !ok:
+			// Spread expressions are kept as keys without a value
+			pairs[index] = stringOf(key)
+		case isShorthand(key, value):
+			pairs[index] = stringOf(key)
+		default:
+			pairs[index] = stringOf(key) + ": " + stringOf(value)
+		}
+	}
+
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+// isShorthand reports whether a map pair was written as {name}, which the
+// parser expands into a key and value for the same identifier token.
+func isShorthand(key ExpressionNode, value ExpressionNode) bool {
+	keyIdentifier, ok := key.(*Identifier)
+
+	if !ok {
+		return false
+	}
+
+	valueIdentifier, ok := value.(*Identifier)
+
+	return ok && keyIdentifier.Token == valueIdentifier.Token
 }
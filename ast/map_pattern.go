@@ -0,0 +1,62 @@
+package ast
+
+import (
+	"strings"
+	"unicode"
+
+	"ghostlang.org/x/ghost/token"
+)
+
+type MapPattern struct {
+	AssignmentNode
+	Token   token.Token                       // The "{" token
+	Pairs   map[ExpressionNode]AssignmentNode // The targets each key's value is assigned to
+	Keys    []ExpressionNode                  // The keys in source order
+	Closing token.Token                       // The closing "}" token
+}
+
+// Span returns the part of the source the map pattern was parsed from.
+func (pattern *MapPattern) Span() Span {
+	return between(tokenSpan(pattern.Token), tokenSpan(pattern.Closing))
+}
+
+// String represents the map pattern as source code, with its pairs in source
+// order. Keys that are valid identifiers are written unquoted, and pairs
+// assigning a key to an identifier of the same name are shortened to {name}.
+func (pattern *MapPattern) String() string {
+	pairs := make([]string, len(pattern.Keys))
+
+	for index, key := range pattern.Keys {
+		target := pattern.Pairs[key]
+		name := stringOf(key)
+
+		if text, ok := key.(*String); ok && isIdentifierName(text.Value) {
+			name = text.Value
+
+			if identifier, ok := target.(*Identifier); ok && identifier.Value == text.Value {
+				pairs[index] = name
+
+				continue
+			}
+		}
+
+		pairs[index] = name + ": " + stringOf(target)
+	}
+
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+// isIdentifierName reports whether text can be written as an identifier.
+func isIdentifierName(text string) bool {
+	if text == "" {
+		return false
+	}
+
+	for index, character := range text {
+		if character != '_' && !unicode.IsLetter(character) && (index == 0 || !unicode.IsDigit(character)) {
+			return false
+		}
+	}
+
+	return true
+}
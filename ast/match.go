@@ -0,0 +1,31 @@
+package ast
+
+import (
+	"strings"
+
+	"ghostlang.org/x/ghost/token"
+)
+
+type Match struct {
+	ExpressionNode
+	Token   token.Token    // The "match" token
+	Value   ExpressionNode // The value matched against the patterns of each case
+	Cases   []*MatchCase   // The cases in the order they are tried
+	Closing token.Token    // The closing "}" token
+}
+
+// Span returns the part of the source the match expression was parsed from.
+func (match *Match) Span() Span {
+	return between(tokenSpan(match.Token), tokenSpan(match.Closing))
+}
+
+// String represents the match expression as source code.
+func (match *Match) String() string {
+	cases := make([]string, len(match.Cases))
+
+	for index, matchCase := range match.Cases {
+		cases[index] = matchCase.String()
+	}
+
+	return "match (" + stringOf(match.Value) + ") { " + strings.Join(cases, " ") + " }"
+}
@@ -0,0 +1,34 @@
+package ast
+
+import (
+	"ghostlang.org/x/ghost/token"
+)
+
+type MatchCase struct {
+	ExpressionNode
+	Token    token.Token      // The "case" or "default" token
+	Default  bool             // Is this the default branch?
+	Patterns []ExpressionNode // Alternative patterns, any of which may match
+	Guard    ExpressionNode   // Optional condition checked after a pattern matches
+	Body     *Block           // The block that will be evaluated if matched
+}
+
+// Span returns the part of the source the match case was parsed from.
+func (matchCase *MatchCase) Span() Span {
+	return between(tokenSpan(matchCase.Token), matchCase.Body.Span())
+}
+
+// String represents the match case as source code.
+func (matchCase *MatchCase) String() string {
+	if matchCase.Default {
+		return "default " + matchCase.Body.String()
+	}
+
+	out := "case " + joinExpressions(matchCase.Patterns)
+
+	if matchCase.Guard != nil {
+		out += " if " + stringOf(matchCase.Guard)
+	}
+
+	return out + " " + matchCase.Body.String()
+}
@@ -8,4 +8,17 @@ type Method struct {
 	Left      ExpressionNode
 	Method    ExpressionNode
 	Arguments []ExpressionNode
+	Named     []*NamedArgument
+	Closing   token.Token // The closing ")" token
+	Optional  bool        // Accessed with "?."
+}
+
+// Span returns the part of the source the method call was parsed from.
+func (method *Method) Span() Span {
+	return between(method.Left.Span(), tokenSpan(method.Closing))
+}
+
+// String represents the method call as source code.
+func (method *Method) String() string {
+	return stringOf(method.Left) + method.Token.Lexeme + stringOf(method.Method) + "(" + joinArguments(method.Arguments, method.Named) + ")"
 }
@@ -0,0 +1,20 @@
+package ast
+
+import "ghostlang.org/x/ghost/token"
+
+type NamedArgument struct {
+	ExpressionNode
+	Token token.Token // The argument name token
+	Name  *Identifier
+	Value ExpressionNode
+}
+
+// Span returns the part of the source the named argument was parsed from.
+func (argument *NamedArgument) Span() Span {
+	return between(tokenSpan(argument.Token), argument.Value.Span())
+}
+
+// String represents the named argument as source code.
+func (argument *NamedArgument) String() string {
+	return argument.Name.Value + ": " + stringOf(argument.Value)
+}
@@ -6,3 +6,13 @@ type Null struct {
 	ExpressionNode
 	Token token.Token
 }
+
+// Span returns the part of the source the null was parsed from.
+func (null *Null) Span() Span {
+	return tokenSpan(null.Token)
+}
+
+// String represents the null as source code.
+func (null *Null) String() string {
+	return "null"
+}
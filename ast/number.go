@@ -10,3 +10,18 @@ type Number struct {
 	Token token.Token
 	Value decimal.Decimal
 }
+
+// Span returns the part of the source the number was parsed from.
+func (number *Number) Span() Span {
+	return tokenSpan(number.Token)
+}
+
+// String represents the number as source code, as it was written if it was
+// read from the source.
+func (number *Number) String() string {
+	if number.Token.Lexeme != "" {
+		return number.Token.Lexeme
+	}
+
+	return number.Value.String()
+}
@@ -4,6 +4,21 @@ import "ghostlang.org/x/ghost/token"
 
 type Postfix struct {
 	ExpressionNode
-	Token    token.Token
+	Token    token.Token    // The token the operator follows
+	Left     ExpressionNode // The identifier, index or property to change
 	Operator string
 }
+
+// Span returns the part of the source the postfix expression was parsed
+// from. The operator directly follows the token before it.
+func (postfix *Postfix) Span() Span {
+	span := tokenSpan(postfix.Token)
+	span.End.Column += len(postfix.Operator)
+
+	return between(postfix.Left.Span(), span)
+}
+
+// String represents the postfix expression as source code.
+func (postfix *Postfix) String() string {
+	return stringOf(postfix.Left) + postfix.Operator
+}
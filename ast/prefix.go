@@ -8,3 +8,14 @@ type Prefix struct {
 	Operator string
 	Right    ExpressionNode
 }
+
+// Span returns the part of the source the prefix expression was parsed from.
+func (prefix *Prefix) Span() Span {
+	return between(tokenSpan(prefix.Token), prefix.Right.Span())
+}
+
+// String represents the prefix expression as source code, parenthesized so
+// it reads the same regardless of where it is used.
+func (prefix *Prefix) String() string {
+	return "(" + prefix.Operator + stringOf(prefix.Right) + ")"
+}
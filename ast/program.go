@@ -1,5 +1,28 @@
 package ast
 
+import "strings"
+
 type Program struct {
 	Statements []StatementNode
 }
+
+// Span returns the part of the source the program was parsed from, from its
+// first statement to its last.
+func (program *Program) Span() Span {
+	if len(program.Statements) == 0 {
+		return Span{}
+	}
+
+	return between(program.Statements[0].Span(), program.Statements[len(program.Statements)-1].Span())
+}
+
+// String represents the program as source code, one statement per line.
+func (program *Program) String() string {
+	statements := make([]string, len(program.Statements))
+
+	for index, statement := range program.Statements {
+		statements[index] = stringOf(statement)
+	}
+
+	return strings.Join(statements, "\n")
+}
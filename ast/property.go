@@ -8,4 +8,15 @@ type Property struct {
 	Token    token.Token
 	Left     ExpressionNode
 	Property ExpressionNode
+	Optional bool // Accessed with "?."
+}
+
+// Span returns the part of the source the property access was parsed from.
+func (property *Property) Span() Span {
+	return between(property.Left.Span(), property.Property.Span())
+}
+
+// String represents the property access as source code.
+func (property *Property) String() string {
+	return stringOf(property.Left) + property.Token.Lexeme + stringOf(property.Property)
 }
@@ -0,0 +1,31 @@
+package ast
+
+import "ghostlang.org/x/ghost/token"
+
+type Range struct {
+	ExpressionNode
+	Token token.Token
+	Start ExpressionNode
+	End   ExpressionNode
+	Step  ExpressionNode // Optional, counting by one when omitted
+}
+
+// Span returns the part of the source the range was parsed from.
+func (rangeExpression *Range) Span() Span {
+	if rangeExpression.Step != nil {
+		return between(rangeExpression.Start.Span(), rangeExpression.Step.Span())
+	}
+
+	return between(rangeExpression.Start.Span(), rangeExpression.End.Span())
+}
+
+// String represents the range as source code.
+func (rangeExpression *Range) String() string {
+	out := stringOf(rangeExpression.Start) + ".." + stringOf(rangeExpression.End)
+
+	if rangeExpression.Step != nil {
+		out += " step " + stringOf(rangeExpression.Step)
+	}
+
+	return out
+}
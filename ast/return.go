@@ -7,3 +7,22 @@ type Return struct {
 	Token token.Token
 	Value ExpressionNode
 }
+
+// Span returns the part of the source the return statement was parsed from.
+func (returnStatement *Return) Span() Span {
+	if returnStatement.Value == nil {
+		return tokenSpan(returnStatement.Token)
+	}
+
+	return between(tokenSpan(returnStatement.Token), returnStatement.Value.Span())
+}
+
+// String represents the return statement as source code. A return without a
+// value returns a null that shares the return token.
+func (returnStatement *Return) String() string {
+	if null, ok := returnStatement.Value.(*Null); ok && null.Token == returnStatement.Token {
+		return "return"
+	}
+
+	return "return " + stringOf(returnStatement.Value)
+}
@@ -0,0 +1,19 @@
+package ast
+
+import "ghostlang.org/x/ghost/token"
+
+type Spread struct {
+	ExpressionNode
+	Token token.Token // The "..." token
+	Value ExpressionNode
+}
+
+// Span returns the part of the source the spread expression was parsed from.
+func (spread *Spread) Span() Span {
+	return between(tokenSpan(spread.Token), spread.Value.Span())
+}
+
+// String represents the spread expression as source code.
+func (spread *Spread) String() string {
+	return "..." + stringOf(spread.Value)
+}
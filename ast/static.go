@@ -0,0 +1,29 @@
+package ast
+
+import "ghostlang.org/x/ghost/token"
+
+type Static struct {
+	StatementNode
+	Token token.Token // The "static" token
+	Name  *Identifier
+	Value ExpressionNode // The initial value of the property, or the method
+}
+
+// Span returns the part of the source the static member was parsed from.
+func (static *Static) Span() Span {
+	return between(tokenSpan(static.Token), static.Value.Span())
+}
+
+// String represents the static member as source code. Static properties
+// without an initial value are given a null that shares the name's token.
+func (static *Static) String() string {
+	if function, ok := static.Value.(*Function); ok {
+		return "static " + function.String()
+	}
+
+	if null, ok := static.Value.(*Null); ok && null.Token == static.Name.Token {
+		return "static " + static.Name.Value
+	}
+
+	return "static " + static.Name.Value + " = " + stringOf(static.Value)
+}
@@ -1,6 +1,8 @@
 package ast
 
 import (
+	"strings"
+
 	"ghostlang.org/x/ghost/token"
 )
 
@@ -9,3 +11,24 @@ type String struct {
 	Token token.Token
 	Value string
 }
+
+// Span returns the part of the source the string was parsed from.
+func (stringLiteral *String) Span() Span {
+	return tokenSpan(stringLiteral.Token)
+}
+
+// String represents the string as source code. Strings are double quoted
+// unless they contain a double quote themselves.
+func (stringLiteral *String) String() string {
+	if strings.Contains(stringLiteral.Value, `"`) {
+		return "'" + stringLiteral.Value + "'"
+	}
+
+	return `"` + escapeInterpolation(stringLiteral.Value) + `"`
+}
+
+// escapeInterpolation escapes the text of a double quoted string, so "${" is
+// not read back as the start of an embedded expression.
+func escapeInterpolation(text string) string {
+	return strings.ReplaceAll(text, "${", `\${`)
+}
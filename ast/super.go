@@ -0,0 +1,18 @@
+package ast
+
+import "ghostlang.org/x/ghost/token"
+
+type Super struct {
+	ExpressionNode
+	Token token.Token
+}
+
+// Span returns the part of the source the super expression was parsed from.
+func (super *Super) Span() Span {
+	return tokenSpan(super.Token)
+}
+
+// String represents the super expression as source code.
+func (super *Super) String() string {
+	return "super"
+}
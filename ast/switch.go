@@ -1,12 +1,31 @@
 package ast
 
 import (
+	"strings"
+
 	"ghostlang.org/x/ghost/token"
 )
 
 type Switch struct {
 	ExpressionNode
-	Token token.Token    // The "switch" token
-	Value ExpressionNode // The value that will be used to determine the case
-	Cases []*Case        // The cases this switch statement will handle
+	Token   token.Token    // The "switch" token
+	Value   ExpressionNode // The value that will be used to determine the case
+	Cases   []*Case        // The cases this switch statement will handle
+	Closing token.Token    // The closing "}" token
+}
+
+// Span returns the part of the source the switch statement was parsed from.
+func (switchStatement *Switch) Span() Span {
+	return between(tokenSpan(switchStatement.Token), tokenSpan(switchStatement.Closing))
+}
+
+// String represents the switch statement as source code.
+func (switchStatement *Switch) String() string {
+	cases := make([]string, len(switchStatement.Cases))
+
+	for index, switchCase := range switchStatement.Cases {
+		cases[index] = switchCase.String()
+	}
+
+	return "switch (" + stringOf(switchStatement.Value) + ") { " + strings.Join(cases, " ") + " }"
 }
@@ -9,3 +9,14 @@ type Ternary struct {
 	IfTrue    ExpressionNode
 	IfFalse   ExpressionNode
 }
+
+// Span returns the part of the source the ternary expression was parsed from.
+func (ternary *Ternary) Span() Span {
+	return between(ternary.Condition.Span(), ternary.IfFalse.Span())
+}
+
+// String represents the ternary expression as source code, parenthesized so
+// it reads the same regardless of where it is used.
+func (ternary *Ternary) String() string {
+	return "(" + stringOf(ternary.Condition) + " ? " + stringOf(ternary.IfTrue) + " : " + stringOf(ternary.IfFalse) + ")"
+}
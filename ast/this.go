@@ -6,3 +6,13 @@ type This struct {
 	ExpressionNode
 	Token token.Token
 }
+
+// Span returns the part of the source the this expression was parsed from.
+func (this *This) Span() Span {
+	return tokenSpan(this.Token)
+}
+
+// String represents the this expression as source code.
+func (this *This) String() string {
+	return "this"
+}
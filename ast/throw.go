@@ -0,0 +1,19 @@
+package ast
+
+import "ghostlang.org/x/ghost/token"
+
+type Throw struct {
+	ExpressionNode
+	Token token.Token
+	Value ExpressionNode
+}
+
+// Span returns the part of the source the throw expression was parsed from.
+func (throw *Throw) Span() Span {
+	return between(tokenSpan(throw.Token), throw.Value.Span())
+}
+
+// String represents the throw expression as source code.
+func (throw *Throw) String() string {
+	return "throw " + stringOf(throw.Value)
+}
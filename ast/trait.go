@@ -8,3 +8,13 @@ type Trait struct {
 	Name  *Identifier
 	Body  *Block
 }
+
+// Span returns the part of the source the trait was parsed from.
+func (trait *Trait) Span() Span {
+	return between(tokenSpan(trait.Token), trait.Body.Span())
+}
+
+// String represents the trait as source code.
+func (trait *Trait) String() string {
+	return "trait " + trait.Name.Value + " " + trait.Body.String()
+}
@@ -0,0 +1,45 @@
+package ast
+
+import "ghostlang.org/x/ghost/token"
+
+type Try struct {
+	ExpressionNode
+	Token     token.Token // The "try" token
+	Body      *Block      // The block that may produce an error
+	Parameter *Identifier // The identifier the caught error is bound to
+	Catch     *Block      // The block evaluated when an error is caught
+	Finally   *Block      // The block that is always evaluated last
+}
+
+// Span returns the part of the source the try expression was parsed from.
+func (try *Try) Span() Span {
+	switch {
+	case try.Finally != nil:
+		return between(tokenSpan(try.Token), try.Finally.Span())
+	case try.Catch != nil:
+		return between(tokenSpan(try.Token), try.Catch.Span())
+	}
+
+	return between(tokenSpan(try.Token), try.Body.Span())
+}
+
+// String represents the try expression as source code.
+func (try *Try) String() string {
+	out := "try " + try.Body.String()
+
+	if try.Catch != nil {
+		out += " catch "
+
+		if try.Parameter != nil {
+			out += "(" + try.Parameter.Value + ") "
+		}
+
+		out += try.Catch.String()
+	}
+
+	if try.Finally != nil {
+		out += " finally " + try.Finally.String()
+	}
+
+	return out
+}
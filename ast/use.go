@@ -7,3 +7,17 @@ type Use struct {
 	Token  token.Token
 	Traits []*Identifier
 }
+
+// Span returns the part of the source the use statement was parsed from.
+func (use *Use) Span() Span {
+	if len(use.Traits) == 0 {
+		return tokenSpan(use.Token)
+	}
+
+	return between(tokenSpan(use.Token), use.Traits[len(use.Traits)-1].Span())
+}
+
+// String represents the use statement as source code.
+func (use *Use) String() string {
+	return "use " + joinIdentifiers(use.Traits)
+}
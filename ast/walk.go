@@ -0,0 +1,254 @@
+package ast
+
+import "reflect"
+
+// Visitor visits the nodes of a syntax tree. Visit is called with each node
+// Walk encounters. If the returned visitor is not nil, Walk visits each of
+// the node's children with it, and then calls its Visit method with nil.
+type Visitor interface {
+	Visit(node Node) Visitor
+}
+
+// Walk traverses a syntax tree in depth-first order, visiting the children of
+// each node in the order they appear in the source. Missing nodes, such as an
+// omitted else branch, are skipped.
+func Walk(visitor Visitor, node Node) {
+	if isNil(node) {
+		return
+	}
+
+	if visitor = visitor.Visit(node); visitor == nil {
+		return
+	}
+
+	for _, child := range Children(node) {
+		Walk(visitor, child)
+	}
+
+	visitor.Visit(nil)
+}
+
+// inspector adapts a function to the Visitor interface.
+type inspector func(Node) bool
+
+func (inspect inspector) Visit(node Node) Visitor {
+	if inspect(node) {
+		return inspect
+	}
+
+	return nil
+}
+
+// Inspect traverses a syntax tree in depth-first order, calling inspect with
+// each node. If inspect returns true, the node's children are inspected as
+// well, followed by a call of inspect with nil.
+func Inspect(node Node, inspect func(Node) bool) {
+	Walk(inspector(inspect), node)
+}
+
+// Children returns the direct children of a node in source order, leaving out
+// missing ones.
+func Children(node Node) []Node {
+	children := &childList{}
+
+	switch node := node.(type) {
+	case *Abstract:
+		children.add(node.Name)
+		children.addIdentifiers(node.Parameters)
+	case *Accessor:
+		children.add(node.Function)
+	case *Assign:
+		children.add(node.Name, node.Value)
+	case *Block:
+		children.addStatements(node.Statements)
+	case *Call:
+		children.add(node.Callee)
+		children.addExpressions(node.Arguments)
+		children.addNamed(node.Named)
+	case *Case:
+		children.addExpressions(node.Value)
+		children.add(node.Body)
+	case *Class:
+		children.addDecorators(node.Decorators)
+		children.add(node.Name, node.Super, node.Body)
+	case *Compound:
+		children.add(node.Left, node.Right)
+	case *Declaration:
+		children.add(node.Name, node.Value)
+	case *Decorator:
+		children.add(node.Expression)
+	case *Enum:
+		children.add(node.Name)
+
+		for _, member := range node.Members {
+			children.add(member)
+		}
+	case *EnumMember:
+		children.add(node.Name)
+		children.addNamed(node.Values)
+	case *Expression:
+		children.add(node.Expression)
+	case *For:
+		children.add(node.Initializer, node.Condition, node.Increment, node.Block)
+	case *ForIn:
+		if node.Key != nil && node.Key.Value != "" {
+			children.add(node.Key)
+		}
+
+		children.add(node.Value, node.Iterable, node.Block)
+	case *Function:
+		children.addDecorators(node.Decorators)
+		children.add(node.Name)
+
+		for _, parameter := range node.Parameters {
+			children.add(parameter, node.Defaults[parameter.Value])
+		}
+
+		children.add(node.Rest, node.Body)
+	case *If:
+		children.add(node.Condition, node.Consequence, node.Alternative)
+	case *Import:
+		children.add(node.Path)
+	case *ImportFrom:
+		for _, alias := range node.Aliases {
+			children.add(node.Identifiers[alias])
+		}
+
+		children.add(node.Path)
+	case *Index:
+		children.add(node.Left, node.Index)
+	case *Infix:
+		children.add(node.Left, node.Right)
+	case *Interpolation:
+		children.addExpressions(node.Parts)
+	case *List:
+		children.addExpressions(node.Elements)
+	case *ListPattern:
+		for _, element := range node.Elements {
+			children.add(element)
+		}
+
+		children.add(node.Rest)
+	case *Map:
+		for _, key := range node.Keys {
+			children.add(key, node.Pairs[key])
+		}
+	case *MapPattern:
+		for _, key := range node.Keys {
+			children.add(key, node.Pairs[key])
+		}
+	case *Match:
+		children.add(node.Value)
+
+		for _, matchCase := range node.Cases {
+			children.add(matchCase)
+		}
+	case *MatchCase:
+		children.addExpressions(node.Patterns)
+		children.add(node.Guard, node.Body)
+	case *Method:
+		children.add(node.Left, node.Method)
+		children.addExpressions(node.Arguments)
+		children.addNamed(node.Named)
+	case *NamedArgument:
+		children.add(node.Name, node.Value)
+	case *Postfix:
+		children.add(node.Left)
+	case *Prefix:
+		children.add(node.Right)
+	case *Program:
+		children.addStatements(node.Statements)
+	case *Property:
+		children.add(node.Left, node.Property)
+	case *Range:
+		children.add(node.Start, node.End, node.Step)
+	case *Return:
+		children.add(node.Value)
+	case *Spread:
+		children.add(node.Value)
+	case *Static:
+		// Static methods are functions that carry their own name
+		if function, ok := node.Value.(*Function); ok && function.Name == node.Name {
+			children.add(function)
+		} else {
+			children.add(node.Name, node.Value)
+		}
+	case *Switch:
+		children.add(node.Value)
+
+		for _, switchCase := range node.Cases {
+			children.add(switchCase)
+		}
+	case *Ternary:
+		children.add(node.Condition, node.IfTrue, node.IfFalse)
+	case *Throw:
+		children.add(node.Value)
+	case *Trait:
+		children.add(node.Name, node.Body)
+	case *Try:
+		children.add(node.Body, node.Parameter, node.Catch, node.Finally)
+	case *Use:
+		children.addIdentifiers(node.Traits)
+	case *While:
+		children.add(node.Condition, node.Consequence)
+	case *Yield:
+		children.add(node.Value)
+	}
+
+	return children.nodes
+}
+
+// childList collects the children of a node, skipping missing ones.
+type childList struct {
+	nodes []Node
+}
+
+func (children *childList) add(nodes ...Node) {
+	for _, node := range nodes {
+		if !isNil(node) {
+			children.nodes = append(children.nodes, node)
+		}
+	}
+}
+
+func (children *childList) addStatements(statements []StatementNode) {
+	for _, statement := range statements {
+		children.add(statement)
+	}
+}
+
+func (children *childList) addExpressions(expressions []ExpressionNode) {
+	for _, expression := range expressions {
+		children.add(expression)
+	}
+}
+
+func (children *childList) addIdentifiers(identifiers []*Identifier) {
+	for _, identifier := range identifiers {
+		children.add(identifier)
+	}
+}
+
+func (children *childList) addNamed(arguments []*NamedArgument) {
+	for _, argument := range arguments {
+		children.add(argument)
+	}
+}
+
+func (children *childList) addDecorators(decorators []*Decorator) {
+	for _, decorator := range decorators {
+		children.add(decorator)
+	}
+}
+
+// isNil reports whether a node is missing, including typed nil pointers such
+// as an unset *Block stored in a Node.
+func isNil(node Node) bool {
+	if node == nil {
+		return true
+	}
+
+	value := reflect.ValueOf(node)
+
+	return value.Kind() == reflect.Ptr && value.IsNil()
+}
@@ -8,3 +8,13 @@ type While struct {
 	Condition   ExpressionNode
 	Consequence *Block
 }
+
+// Span returns the part of the source the while loop was parsed from.
+func (whileExpression *While) Span() Span {
+	return between(tokenSpan(whileExpression.Token), whileExpression.Consequence.Span())
+}
+
+// String represents the while loop as source code.
+func (whileExpression *While) String() string {
+	return "while (" + stringOf(whileExpression.Condition) + ") " + whileExpression.Consequence.String()
+}
@@ -0,0 +1,28 @@
+package ast
+
+import "ghostlang.org/x/ghost/token"
+
+type Yield struct {
+	ExpressionNode
+	Token token.Token
+	Value ExpressionNode
+}
+
+// Span returns the part of the source the yield expression was parsed from.
+func (yield *Yield) Span() Span {
+	if yield.Value == nil {
+		return tokenSpan(yield.Token)
+	}
+
+	return between(tokenSpan(yield.Token), yield.Value.Span())
+}
+
+// String represents the yield expression as source code. A yield without a
+// value yields a null that shares the yield token.
+func (yield *Yield) String() string {
+	if null, ok := yield.Value.(*Null); ok && null.Token == yield.Token {
+		return "yield"
+	}
+
+	return "yield " + stringOf(yield.Value)
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// context is the number of unchanged lines shown around each change.
+const context = 3
+
+// edit is a line kept, removed or added when turning one text into another.
+type edit struct {
+	operation byte // ' ', '-' or '+'
+	line      string
+}
+
+// unifiedDiff shows the changes between the original and formatted source of
+// file in the unified diff format, or nothing if there are none.
+func unifiedDiff(file string, original string, formatted string) string {
+	if original == formatted {
+		return ""
+	}
+
+	edits := diffLines(splitLines(original), splitLines(formatted))
+
+	var out strings.Builder
+
+	fmt.Fprintf(&out, "--- %s (original)\n+++ %s (formatted)\n", file, file)
+
+	// Line numbers in the original and formatted source at the current edit
+	originalLine, formattedLine := 1, 1
+
+	for index := 0; index < len(edits); {
+		if edits[index].operation == ' ' {
+			originalLine++
+			formattedLine++
+			index++
+
+			continue
+		}
+
+		// Each hunk begins with context before its first change, and ends once
+		// more than twice the context separates it from the next change.
+		start := max(index-context, 0)
+		end := index
+
+		for unchanged := 0; end < len(edits) && unchanged <= 2*context; end++ {
+			if edits[end].operation == ' ' {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+		}
+
+		for end > index && edits[end-1].operation == ' ' {
+			end--
+		}
+
+		end = min(end+context, len(edits))
+
+		hunkOriginal, hunkFormatted := originalLine-(index-start), formattedLine-(index-start)
+		originalCount, formattedCount := 0, 0
+
+		for _, edit := range edits[start:end] {
+			if edit.operation != '+' {
+				originalCount++
+			}
+
+			if edit.operation != '-' {
+				formattedCount++
+			}
+		}
+
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(hunkOriginal, originalCount), hunkRange(hunkFormatted, formattedCount))
+
+		for _, edit := range edits[start:end] {
+			out.WriteString(string(edit.operation) + edit.line)
+
+			if !strings.HasSuffix(edit.line, "\n") {
+				out.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+
+		originalLine += originalCount - (index - start)
+		formattedLine += formattedCount - (index - start)
+		index = end
+	}
+
+	return out.String()
+}
+
+// hunkRange represents the lines a hunk covers as its first line and count.
+// An empty range refers to the line before it.
+func hunkRange(line int, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", line-1)
+	}
+
+	if count == 1 {
+		return fmt.Sprintf("%d", line)
+	}
+
+	return fmt.Sprintf("%d,%d", line, count)
+}
+
+// splitLines splits text into lines, keeping their line breaks so a missing
+// one at the end of the text counts as a change.
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// diffLines finds the shortest list of edits turning the original lines into
+// the formatted ones, using Myers' difference algorithm.
+func diffLines(original []string, formatted []string) []edit {
+	n, m := len(original), len(formatted)
+	offset := n + m + 1
+
+	// furthest holds the furthest original line reached on each diagonal, and
+	// trace the state of it before each round of edits.
+	furthest := make([]int, 2*offset+1)
+	trace := [][]int{}
+
+	for distance := 0; distance <= n+m; distance++ {
+		trace = append(trace, append([]int(nil), furthest...))
+
+		for diagonal := -distance; diagonal <= distance; diagonal += 2 {
+			var x int
+
+			if diagonal == -distance || diagonal != distance && furthest[offset+diagonal-1] < furthest[offset+diagonal+1] {
+				x = furthest[offset+diagonal+1]
+			} else {
+				x = furthest[offset+diagonal-1] + 1
+			}
+
+			y := x - diagonal
+
+			for x < n && y < m && original[x] == formatted[y] {
+				x++
+				y++
+			}
+
+			furthest[offset+diagonal] = x
+
+			if x >= n && y >= m {
+				return backtrack(original, formatted, trace, offset)
+			}
+		}
+	}
+
+	return nil
+}
+
+// backtrack follows the trace of diffLines back from the end of both texts,
+// collecting the edits that were made along the way.
+func backtrack(original []string, formatted []string, trace [][]int, offset int) []edit {
+	edits := []edit{}
+	x, y := len(original), len(formatted)
+
+	for distance := len(trace) - 1; distance >= 0; distance-- {
+		furthest := trace[distance]
+		diagonal := x - y
+
+		var previous int
+
+		if diagonal == -distance || diagonal != distance && furthest[offset+diagonal-1] < furthest[offset+diagonal+1] {
+			previous = diagonal + 1
+		} else {
+			previous = diagonal - 1
+		}
+
+		previousX := furthest[offset+previous]
+		previousY := previousX - previous
+
+		for x > previousX && y > previousY {
+			x--
+			y--
+			edits = append(edits, edit{' ', original[x]})
+		}
+
+		if distance == 0 {
+			break
+		}
+
+		if x == previousX {
+			y--
+			edits = append(edits, edit{'+', formatted[y]})
+		} else {
+			x--
+			edits = append(edits, edit{'-', original[x]})
+		}
+	}
+
+	for left, right := 0, len(edits)-1; left < right; left, right = left+1, right-1 {
+		edits[left], edits[right] = edits[right], edits[left]
+	}
+
+	return edits
+}
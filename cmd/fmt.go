@@ -0,0 +1,130 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"ghostlang.org/x/ghost/format"
+	"ghostlang.org/x/ghost/log"
+)
+
+// fmtCommand formats Ghost source files, printing the formatted source of
+// each. With -w the files are rewritten instead, and with -d the changes are
+// shown as a diff. Without any files, standard input is formatted. It returns
+// the status the program should exit with.
+func fmtCommand(args []string) int {
+	flags := flag.NewFlagSet("fmt", flag.ExitOnError)
+	write := flags.Bool("w", false, "write the formatted source back to the file")
+	diff := flags.Bool("d", false, "display a diff of the changes instead of the formatted source")
+
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s fmt [options] [<filename>...]\n", path.Base(os.Args[0]))
+		flags.PrintDefaults()
+	}
+
+	flags.Parse(args)
+
+	files := flags.Args()
+
+	if len(files) == 0 {
+		if *write {
+			log.Error("system error: cannot write the formatted source of standard input")
+
+			return 1
+		}
+
+		source, err := io.ReadAll(os.Stdin)
+
+		if err != nil {
+			log.Error("system error: could not read standard input: %s", err)
+
+			return 1
+		}
+
+		return formatSource(string(source), "<stdin>", *diff)
+	}
+
+	status := 0
+
+	for _, file := range files {
+		source, err := os.ReadFile(file)
+
+		if err != nil {
+			log.Error("system error: could not open source file %s: %s", file, err)
+
+			status = 1
+
+			continue
+		}
+
+		if !*write {
+			if formatSource(string(source), file, *diff) != 0 {
+				status = 1
+			}
+
+			continue
+		}
+
+		formatted, ok := formatFile(string(source), file)
+
+		if !ok {
+			status = 1
+
+			continue
+		}
+
+		if *diff {
+			fmt.Print(unifiedDiff(file, string(source), formatted))
+		}
+
+		if formatted == string(source) {
+			continue
+		}
+
+		info, err := os.Stat(file)
+
+		if err == nil {
+			err = os.WriteFile(file, []byte(formatted), info.Mode().Perm())
+		}
+
+		if err != nil {
+			log.Error("system error: could not write source file %s: %s", file, err)
+
+			status = 1
+		}
+	}
+
+	return status
+}
+
+// formatSource prints the formatted source of file, or the diff to it.
+func formatSource(source string, file string, diff bool) int {
+	formatted, ok := formatFile(source, file)
+
+	if !ok {
+		return 1
+	}
+
+	if diff {
+		fmt.Print(unifiedDiff(file, source, formatted))
+	} else {
+		fmt.Print(formatted)
+	}
+
+	return 0
+}
+
+// formatFile formats the source of file, logging its syntax errors if it
+// can't be formatted.
+func formatFile(source string, file string) (string, bool) {
+	formatted, diagnostics := format.Source(source, file)
+
+	for _, diagnostic := range diagnostics {
+		log.Error("%s\n%s", diagnostic.String(), diagnostic.Snippet())
+	}
+
+	return formatted, len(diagnostics) == 0
+}
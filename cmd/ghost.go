@@ -25,7 +25,7 @@ var (
 
 func init() {
 	flag.Usage = func() {
-		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [options] [<filename>]\n", path.Base(os.Args[0]))
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [options] [<filename>]\n       %s fmt [-w] [-d] [<filename>...]\n", path.Base(os.Args[0]), path.Base(os.Args[0]))
 		flag.PrintDefaults()
 		os.Exit(0)
 	}
@@ -50,6 +50,10 @@ func main() {
 
 	args := flag.Args()
 
+	if len(args) > 0 && args[0] == "fmt" {
+		os.Exit(fmtCommand(args[1:]))
+	}
+
 	if len(args) == 0 {
 		fmt.Printf("Ghost (%s)\n", version.Version)
 		fmt.Printf("Press Ctrl + C to exit\n\n")
@@ -6,6 +6,7 @@ func helpCommand() {
 	fmt.Println("Usage:")
 	fmt.Println()
 	fmt.Println("    ghost [flags] {file}")
+	fmt.Println("    ghost fmt [-w] [-d] {file...}")
 	fmt.Println()
 	fmt.Println("Flags:")
 	fmt.Println()
@@ -29,5 +30,10 @@ func helpCommand() {
 	fmt.Println("            and enter interactive mode (REPL)")
 	fmt.Println("            with the scripts environment intact")
 	fmt.Println()
+	fmt.Println("    ghost fmt -w example.ghost")
+	fmt.Println()
+	fmt.Println("            Format source file (example.ghost) in place,")
+	fmt.Println("            or show the changes as a diff with -d")
+	fmt.Println()
 	fmt.Println()
 }
@@ -0,0 +1,18 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+)
+
+func evaluateAbstract(node *ast.Abstract, scope *object.Scope) object.Object {
+	trait, ok := scope.Self.(*object.Trait)
+
+	if !ok {
+		return newError(object.RuntimeError, node.Token, "abstract methods can only be declared in a trait body")
+	}
+
+	trait.Abstract = append(trait.Abstract, node)
+
+	return nil
+}
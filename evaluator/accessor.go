@@ -0,0 +1,24 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+)
+
+func evaluateAccessor(node *ast.Accessor, scope *object.Scope) object.Object {
+	class, ok := scope.Self.(*object.Class)
+
+	if !ok {
+		return newError(object.RuntimeError, node.Token, "getters and setters can only be declared in a class body")
+	}
+
+	accessor := newFunction(node.Function, scope)
+
+	if node.Setter {
+		class.Setters[node.Function.Name.Value] = accessor
+	} else {
+		class.Getters[node.Function.Name.Value] = accessor
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package evaluator
 import (
 	"ghostlang.org/x/ghost/ast"
 	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/token"
 	"ghostlang.org/x/ghost/value"
 )
 
@@ -13,21 +14,33 @@ func evaluateAssign(node *ast.Assign, scope *object.Scope) object.Object {
 		return value
 	}
 
-	switch assignment := node.Name.(type) {
+	return evaluateAssignment(node.Token, node.Name, value, scope)
+}
+
+// evaluateAssignment assigns the value to the target, which may be an
+// identifier, index, property, or a list or map destructuring pattern.
+func evaluateAssignment(tok token.Token, target ast.AssignmentNode, value object.Object, scope *object.Scope) object.Object {
+	switch assignment := target.(type) {
 	case *ast.Identifier:
 		return evaluateIdentifierAssignment(assignment, value, scope)
 	case *ast.Index:
 		return evaluateIndexAssignment(assignment, value, scope)
 	case *ast.Property:
 		return evaluatePropertyAssignment(assignment, value, scope)
+	case *ast.ListPattern:
+		return evaluateListPatternAssignment(assignment, value, scope)
+	case *ast.MapPattern:
+		return evaluateMapPatternAssignment(assignment, value, scope)
 	}
 
-	object.NewError("%d:%d:%s: runtime error: cannot assign variable to a %T", node.Token.Line, node.Token.Column, node.Token.File, node.Name)
-
-	return nil
+	return newError(object.TypeError, tok, "cannot assign variable to a %T", target)
 }
 
 func evaluateIdentifierAssignment(node *ast.Identifier, value object.Object, scope *object.Scope) object.Object {
+	if scope.Environment.IsConstant(node.Value) {
+		return newError(object.RuntimeError, node.Token, "cannot reassign constant: %s", node.Value)
+	}
+
 	switch this := scope.Self.(type) {
 	case *object.Class:
 		this.Environment.Set(node.Value, value)
@@ -42,13 +55,18 @@ func evaluateIndexAssignment(node *ast.Index, assignmentValue object.Object, sco
 	left := Evaluate(node.Left, scope)
 	index := Evaluate(node.Index, scope)
 
+	return assignIndex(node, left, index, assignmentValue)
+}
+
+// assignIndex assigns the index of an evaluated value.
+func assignIndex(node *ast.Index, left object.Object, index object.Object, assignmentValue object.Object) object.Object {
 	switch obj := left.(type) {
 	case *object.List:
 		idx := int(index.(*object.Number).Value.IntPart())
 		elements := obj.Elements
 
 		if idx < 0 {
-			return object.NewError("%d:%d:%s: runtime error: index out of range: %d", node.Token.Line, node.Token.Column, node.Token.File, idx)
+			return newError(object.IndexError, node.Token, "index out of range: %d", idx)
 		}
 
 		if idx >= len(elements) {
@@ -64,12 +82,14 @@ func evaluateIndexAssignment(node *ast.Index, assignmentValue object.Object, sco
 		key, ok := index.(object.Mappable)
 
 		if !ok {
-			return object.NewError("%d:%d:%s: runtime error: unusable as a map key: %s", node.Token.Line, node.Token.Column, node.Token.File, index.Type())
+			return newError(object.TypeError, node.Token, "unusable as a map key: %s", index.Type())
 		}
 
 		hashed := key.MapKey()
 		pair := object.MapPair{Key: index, Value: assignmentValue}
 		obj.Pairs[hashed] = pair
+	case *object.Instance:
+		return evaluateInstanceIndexAssignment(node, obj, index, assignmentValue)
 	}
 
 	return nil
@@ -78,9 +98,16 @@ func evaluateIndexAssignment(node *ast.Index, assignmentValue object.Object, sco
 func evaluatePropertyAssignment(node *ast.Property, assignmentValue object.Object, scope *object.Scope) object.Object {
 	left := Evaluate(node.Left, scope)
 
+	return assignProperty(node, left, assignmentValue, scope)
+}
+
+// assignProperty assigns the property of an evaluated value.
+func assignProperty(node *ast.Property, left object.Object, assignmentValue object.Object, scope *object.Scope) object.Object {
 	switch obj := left.(type) {
 	case *object.Instance:
-		obj.Environment.Set(node.Property.(*ast.Identifier).Value, assignmentValue)
+		return evaluateInstancePropertyAssignment(node, obj, assignmentValue, scope)
+	case *object.Class:
+		obj.Static.Set(node.Property.(*ast.Identifier).Value, assignmentValue)
 
 		return nil
 	case *object.Map:
@@ -92,5 +119,40 @@ func evaluatePropertyAssignment(node *ast.Property, assignmentValue object.Objec
 		return nil
 	}
 
-	return object.NewError("%d:%d:%s: runtime error: can only assign properties to maps, got %s", node.Token.Line, node.Token.Column, node.Token.File, left.Type())
+	return newError(object.TypeError, node.Token, "can only assign properties to maps, got %s", left.Type())
+}
+
+// evaluateInstancePropertyAssignment assigns the property of an instance,
+// calling its setter if the class defines one. Properties with only a getter
+// cannot be assigned.
+func evaluateInstancePropertyAssignment(node *ast.Property, instance *object.Instance, assignmentValue object.Object, scope *object.Scope) object.Object {
+	property := node.Property.(*ast.Identifier)
+
+	if err := checkPrivateAccess(node, instance, scope); err != nil {
+		return err
+	}
+
+	if isPrivate(property.Value) {
+		instance.SetPrivateProperty(scope.Class, property.Value, assignmentValue)
+
+		return nil
+	}
+
+	if setter, definedIn := instance.Class.FindSetter(property.Value); setter != nil {
+		result := callAccessor(node.Token, setter, instance, definedIn, property.Value, []object.Object{assignmentValue})
+
+		if isError(result) {
+			return result
+		}
+
+		return nil
+	}
+
+	if getter, _ := instance.Class.FindGetter(property.Value); getter != nil {
+		return newError(object.RuntimeError, node.Token, "cannot assign property %s for class %s, it only has a getter", property.Value, instance.Class.Name.Value)
+	}
+
+	instance.Environment.Set(property.Value, assignmentValue)
+
+	return nil
 }
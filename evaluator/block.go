@@ -5,7 +5,16 @@ import (
 	"ghostlang.org/x/ghost/object"
 )
 
+// evaluateBlock evaluates the statements of a block within a new block scope.
+// Variables declared with let or const are local to the block, while assigning
+// any other variable updates the enclosing scope.
 func evaluateBlock(node *ast.Block, scope *object.Scope) object.Object {
+	return evaluateStatements(node, newBlockScope(scope))
+}
+
+// evaluateStatements evaluates the statements of a block within a block scope
+// that was already created for it, such as the scope of a loop iteration.
+func evaluateStatements(node *ast.Block, scope *object.Scope) object.Object {
 	var result object.Object
 
 	for _, statement := range node.Statements {
@@ -24,5 +24,5 @@ func evaluateBooleanInfix(node *ast.Infix, left object.Object, right object.Obje
 		return toBooleanValue(leftValue != rightValue)
 	}
 
-	return newError("%d:%d:%s: runtime error: unknown operator: %s %s %s", node.Token.Line, node.Token.Column, node.Token.File, right.Type(), node.Operator, left.Type())
+	return newError(object.TypeError, node.Token, "unknown operator: %s %s %s", right.Type(), node.Operator, left.Type())
 }
@@ -8,9 +8,9 @@ import (
 )
 
 func evaluateCall(node *ast.Call, scope *object.Scope) object.Object {
-	callee := Evaluate(node.Callee, scope)
+	callee := evaluateChainLink(node.Callee, scope)
 
-	if isError(callee) {
+	if isError(callee) || callee == shortCircuit {
 		return callee
 	}
 
@@ -20,14 +20,31 @@ func evaluateCall(node *ast.Call, scope *object.Scope) object.Object {
 		return arguments[0]
 	}
 
-	return unwrapCall(node.Token, callee, arguments, scope)
+	named, err := evaluateNamedArguments(node.Named, scope)
+
+	if err != nil {
+		return err
+	}
+
+	return unwrapCall(node.Token, callee, arguments, named, scope)
 }
 
-func unwrapCall(tok token.Token, callee object.Object, arguments []object.Object, scope *object.Scope) object.Object {
+// unwrapCall calls the callee with the evaluated arguments. Named arguments
+// are matched against the parameters of user defined functions, and passed as
+// a trailing map to library functions that accept them.
+func unwrapCall(tok token.Token, callee object.Object, arguments []object.Object, named *object.Map, scope *object.Scope) object.Object {
 	switch callee := callee.(type) {
 	case *object.LibraryFunction:
+		if named != nil {
+			if !callee.NamedArguments {
+				return newError(object.ArgumentError, tok, "%s does not accept named arguments", callee.Name)
+			}
+
+			arguments = append(arguments, named)
+		}
+
 		if result := callee.Function(scope, tok, arguments...); result != nil {
-			return result
+			return traceError(result, callee.Name, tok)
 		}
 
 		return nil
@@ -38,13 +55,29 @@ func unwrapCall(tok token.Token, callee object.Object, arguments []object.Object
 
 		return nil
 	case *object.Function:
+		arguments, err := bindNamedArguments(tok, callee, arguments, named)
+
+		if err != nil {
+			return err
+		}
+
 		functionEnvironment := createFunctionEnvironment(callee, arguments)
 		functionScope := &object.Scope{Self: callee, Environment: functionEnvironment}
+
+		if callee.Arrow {
+			functionScope.Self = callee.Scope.Self
+			functionScope.Class = callee.Scope.Class
+		}
+
+		if callee.Generator {
+			return object.NewGenerator(callee.Body, functionScope)
+		}
+
 		evaluated := Evaluate(callee.Body, functionScope)
 
-		return unwrapReturn(evaluated)
+		return traceError(unwrapReturn(evaluated), callee.Describe(), tok)
 	default:
-		return newError("%d:%d:%s: runtime error: uncallable object: %s", tok.Line, tok.Column, tok.File, callee.Type())
+		return newError(object.TypeError, tok, "uncallable object: %s", callee.Type())
 	}
 }
 
@@ -10,6 +10,9 @@ func evaluateClass(node *ast.Class, scope *object.Scope) object.Object {
 		Name:        node.Name,
 		Scope:       scope,
 		Environment: object.NewEnvironment(),
+		Static:      object.NewEnvironment(),
+		Getters:     make(map[string]*object.Function),
+		Setters:     make(map[string]*object.Function),
 		Super:       nil,
 	}
 
@@ -18,13 +21,13 @@ func evaluateClass(node *ast.Class, scope *object.Scope) object.Object {
 		identifier, ok := scope.Environment.Get(node.Super.Value)
 
 		if !ok {
-			return object.NewError("%d:%d:%s: runtime error: identifier '%s' not found in '%s'", node.Super.Token.Line, node.Super.Token.Column, node.Super.Token.File, node.Super.Value, scope.Self.String())
+			return newError(object.NameError, node.Super.Token, "identifier '%s' not found in '%s'", node.Super.Value, scope.Self.String())
 		}
 
 		super, ok := identifier.(*object.Class)
 
 		if !ok {
-			return object.NewError("%d:%d:%s: runtime error: referenced identifier in extends not a class, got=%T", node.Super.Token.Line, node.Super.Token.Column, node.Super.Token.File, super)
+			return newError(object.TypeError, node.Super.Token, "referenced identifier in extends not a class, got=%T", super)
 		}
 
 		class.Super = super
@@ -40,6 +43,22 @@ func evaluateClass(node *ast.Class, scope *object.Scope) object.Object {
 		return result
 	}
 
+	if err := checkTraits(node, class); err != nil {
+		return err
+	}
+
+	if len(node.Decorators) > 0 {
+		decorated := applyDecorators(node.Decorators, class, scope)
+
+		if isError(decorated) {
+			return decorated
+		}
+
+		scope.Environment.Set(node.Name.Value, decorated)
+
+		return decorated
+	}
+
 	scope.Environment.Set(node.Name.Value, class)
 
 	return class
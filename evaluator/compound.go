@@ -5,6 +5,9 @@ import (
 	"ghostlang.org/x/ghost/object"
 )
 
+// evaluateCompound applies the operator of a compound assignment to its target
+// and assigns the result back to it. The target may be an identifier, index or
+// property, whose operands are only evaluated once.
 func evaluateCompound(node *ast.Compound, scope *object.Scope) object.Object {
 	infix := &ast.Infix{
 		Token:    node.Token,
@@ -13,9 +16,74 @@ func evaluateCompound(node *ast.Compound, scope *object.Scope) object.Object {
 		Right:    node.Right,
 	}
 
-	value := evaluateInfix(infix, scope)
+	switch target := node.Left.(type) {
+	case *ast.Identifier:
+		value := evaluateInfix(infix, scope)
 
-	scope.Environment.Set(node.Left.(*ast.Identifier).Value, value)
+		if isError(value) {
+			return value
+		}
 
-	return nil
+		return evaluateIdentifierAssignment(target, value, scope)
+	case *ast.Index:
+		if target.Optional {
+			break
+		}
+
+		left := Evaluate(target.Left, scope)
+
+		if isError(left) {
+			return left
+		}
+
+		index := Evaluate(target.Index, scope)
+
+		if isError(index) {
+			return index
+		}
+
+		value := evaluateCompoundValue(infix, evaluateIndexOf(target, left, index), scope)
+
+		if isError(value) {
+			return value
+		}
+
+		return assignIndex(target, left, index, value)
+	case *ast.Property:
+		if target.Optional {
+			break
+		}
+
+		left := Evaluate(target.Left, scope)
+
+		if isError(left) {
+			return left
+		}
+
+		value := evaluateCompoundValue(infix, evaluatePropertyOf(target, left, scope), scope)
+
+		if isError(value) {
+			return value
+		}
+
+		return assignProperty(target, left, value, scope)
+	}
+
+	return newError(object.TypeError, node.Token, "cannot assign to %s", node.Left.String())
+}
+
+// evaluateCompoundValue applies the operator of a compound assignment to the
+// current value of its target and its right side.
+func evaluateCompoundValue(infix *ast.Infix, current object.Object, scope *object.Scope) object.Object {
+	if isError(current) {
+		return current
+	}
+
+	right := Evaluate(infix.Right, scope)
+
+	if isError(right) {
+		return right
+	}
+
+	return evaluateInfixOperands(infix, current, right)
 }
@@ -0,0 +1,52 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/value"
+)
+
+// evaluateDeclaration binds the declared names within the current block,
+// shadowing any variables of the same name in enclosing blocks. A name can only
+// be declared once within the same block.
+func evaluateDeclaration(node *ast.Declaration, scope *object.Scope) object.Object {
+	var declared object.Object = value.NULL
+
+	if node.Value != nil {
+		declared = Evaluate(node.Value, scope)
+
+		if isError(declared) {
+			return declared
+		}
+	}
+
+	identifiers := patternIdentifiers(node.Name)
+
+	for _, identifier := range identifiers {
+		if scope.Environment.IsDeclared(identifier.Value) {
+			return newError(object.NameError, identifier.Token, "cannot redeclare variable: %s", identifier.Value)
+		}
+
+		scope.Environment.Declare(identifier.Value, value.NULL)
+	}
+
+	if identifier, ok := node.Name.(*ast.Identifier); ok {
+		scope.Environment.Declare(identifier.Value, declared)
+	} else {
+		result := evaluateAssignment(node.Token, node.Name, declared, scope)
+
+		if isError(result) {
+			return result
+		}
+	}
+
+	if node.Constant {
+		for _, identifier := range identifiers {
+			bound, _ := scope.Environment.Get(identifier.Value)
+
+			scope.Environment.DeclareConstant(identifier.Value, bound)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+)
+
+// applyDecorators passes a declared function or class through its decorators,
+// starting with the one closest to the declaration, and returns the
+// replacement. Decorators returning null, such as ones that only register the
+// declaration somewhere, keep it unchanged.
+func applyDecorators(decorators []*ast.Decorator, declared object.Object, scope *object.Scope) object.Object {
+	for index := len(decorators) - 1; index >= 0; index-- {
+		decorator := decorators[index]
+		callee := Evaluate(decorator.Expression, scope)
+
+		if isError(callee) {
+			return callee
+		}
+
+		result := unwrapCall(decorator.Token, callee, []object.Object{declared}, nil, scope)
+
+		if isError(result) {
+			return result
+		}
+
+		if result != nil && result.Type() != object.NULL {
+			declared = result
+		}
+	}
+
+	return declared
+}
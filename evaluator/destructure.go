@@ -0,0 +1,115 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/value"
+)
+
+// evaluateListPatternAssignment assigns each element of the list to the
+// pattern's targets in order. Targets without a matching element are assigned
+// null, and the rest target receives a list of the remaining elements.
+func evaluateListPatternAssignment(node *ast.ListPattern, assignmentValue object.Object, scope *object.Scope) object.Object {
+	list, ok := assignmentValue.(*object.List)
+
+	if !ok {
+		return newError(object.TypeError, node.Token, "cannot destructure %s as a list", assignmentValue.Type())
+	}
+
+	elements := list.Elements
+
+	for index, target := range node.Elements {
+		var element object.Object = value.NULL
+
+		if index < len(elements) {
+			element = elements[index]
+		}
+
+		result := evaluateAssignment(node.Token, target, element, scope)
+
+		if isError(result) {
+			return result
+		}
+	}
+
+	if node.Rest == nil {
+		return nil
+	}
+
+	rest := []object.Object{}
+
+	if len(elements) > len(node.Elements) {
+		rest = append(rest, elements[len(node.Elements):]...)
+	}
+
+	return evaluateAssignment(node.Token, node.Rest, &object.List{Elements: rest}, scope)
+}
+
+// evaluateMapPatternAssignment assigns the value of each key in the map to the
+// key's target. Targets whose key is missing from the map are assigned null.
+func evaluateMapPatternAssignment(node *ast.MapPattern, assignmentValue object.Object, scope *object.Scope) object.Object {
+	mapObject, ok := assignmentValue.(*object.Map)
+
+	if !ok {
+		return newError(object.TypeError, node.Token, "cannot destructure %s as a map", assignmentValue.Type())
+	}
+
+	for keyNode, target := range node.Pairs {
+		key := Evaluate(keyNode, scope)
+
+		if isError(key) {
+			return key
+		}
+
+		mapKey, ok := key.(object.Mappable)
+
+		if !ok {
+			return newError(object.TypeError, node.Token, "unusable as a map key: %s", key.Type())
+		}
+
+		var element object.Object = value.NULL
+
+		if pair, ok := mapObject.Pairs[mapKey.MapKey()]; ok {
+			element = pair.Value
+		}
+
+		result := evaluateAssignment(node.Token, target, element, scope)
+
+		if isError(result) {
+			return result
+		}
+	}
+
+	return nil
+}
+
+// patternIdentifiers returns the identifiers a destructuring pattern assigns
+// to, including those within nested patterns.
+func patternIdentifiers(target ast.AssignmentNode) []*ast.Identifier {
+	switch node := target.(type) {
+	case *ast.Identifier:
+		return []*ast.Identifier{node}
+	case *ast.ListPattern:
+		identifiers := []*ast.Identifier{}
+
+		for _, element := range node.Elements {
+			identifiers = append(identifiers, patternIdentifiers(element)...)
+		}
+
+		if node.Rest != nil {
+			identifiers = append(identifiers, patternIdentifiers(node.Rest)...)
+		}
+
+		return identifiers
+	case *ast.MapPattern:
+		identifiers := []*ast.Identifier{}
+
+		for _, element := range node.Pairs {
+			identifiers = append(identifiers, patternIdentifiers(element)...)
+		}
+
+		return identifiers
+	}
+
+	return nil
+}
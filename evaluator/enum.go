@@ -0,0 +1,47 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+)
+
+func evaluateEnum(node *ast.Enum, scope *object.Scope) object.Object {
+	enum := object.NewEnum(node.Name.Value)
+
+	for index, memberNode := range node.Members {
+		member := &object.EnumMember{
+			Enum:   enum,
+			Name:   memberNode.Name.Value,
+			Value:  int64(index),
+			Values: make(map[string]object.Object),
+		}
+
+		for _, argument := range memberNode.Values {
+			value := Evaluate(argument.Value, scope)
+
+			if isError(value) {
+				return value
+			}
+
+			member.Values[argument.Name.Value] = value
+		}
+
+		enum.Members = append(enum.Members, member)
+	}
+
+	scope.Environment.Set(node.Name.Value, enum)
+
+	return enum
+}
+
+// evaluateEnumMemberInfix compares enum members by identity.
+func evaluateEnumMemberInfix(node *ast.Infix, left object.Object, right object.Object) object.Object {
+	switch node.Operator {
+	case "==":
+		return toBooleanValue(left == right)
+	case "!=":
+		return toBooleanValue(left != right)
+	}
+
+	return newError(object.TypeError, node.Token, "unknown operator: %s %s %s", left.Type(), node.Operator, right.Type())
+}
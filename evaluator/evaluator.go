@@ -1,10 +1,10 @@
 package evaluator
 
 import (
-	"fmt"
-
 	"ghostlang.org/x/ghost/ast"
 	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/parser"
+	"ghostlang.org/x/ghost/token"
 	"ghostlang.org/x/ghost/value"
 )
 
@@ -16,6 +16,10 @@ func Evaluate(node ast.Node, scope *object.Scope) object.Object {
 	switch node := node.(type) {
 	case *ast.Program:
 		return evaluateProgram(node, scope)
+	case *ast.Abstract:
+		return evaluateAbstract(node, scope)
+	case *ast.Accessor:
+		return evaluateAccessor(node, scope)
 	case *ast.Assign:
 		return evaluateAssign(node, scope)
 	case *ast.Block:
@@ -25,13 +29,17 @@ func Evaluate(node ast.Node, scope *object.Scope) object.Object {
 	case *ast.Break:
 		return evaluateBreak(node, scope)
 	case *ast.Call:
-		return evaluateCall(node, scope)
+		return endChain(evaluateCall(node, scope))
 	case *ast.Class:
 		return evaluateClass(node, scope)
 	case *ast.Compound:
 		return evaluateCompound(node, scope)
 	case *ast.Continue:
 		return evaluateContinue(node, scope)
+	case *ast.Declaration:
+		return evaluateDeclaration(node, scope)
+	case *ast.Enum:
+		return evaluateEnum(node, scope)
 	case *ast.Expression:
 		return Evaluate(node.Expression, scope)
 	case *ast.For:
@@ -49,15 +57,19 @@ func Evaluate(node ast.Node, scope *object.Scope) object.Object {
 	case *ast.ImportFrom:
 		return evaluateImportFrom(node, scope)
 	case *ast.Index:
-		return evaluateIndex(node, scope)
+		return endChain(evaluateIndex(node, scope))
 	case *ast.Infix:
 		return evaluateInfix(node, scope)
+	case *ast.Interpolation:
+		return evaluateInterpolation(node, scope)
 	case *ast.List:
 		return evaluateList(node, scope)
 	case *ast.Map:
 		return evaluateMap(node, scope)
+	case *ast.Match:
+		return evaluateMatch(node, scope)
 	case *ast.Method:
-		return evaluateMethod(node, scope)
+		return endChain(evaluateMethod(node, scope))
 	case *ast.Null:
 		return evaluateNull(node, scope)
 	case *ast.Number:
@@ -67,11 +79,19 @@ func Evaluate(node ast.Node, scope *object.Scope) object.Object {
 	case *ast.Prefix:
 		return evaluatePrefix(node, scope)
 	case *ast.Property:
-		return evaluateProperty(node, scope)
+		return endChain(evaluateProperty(node, scope))
+	case *ast.Range:
+		return evaluateRange(node, scope)
 	case *ast.Return:
 		return evaluateReturn(node, scope)
+	case *ast.Static:
+		return evaluateStatic(node, scope)
 	case *ast.String:
 		return evaluateString(node, scope)
+	case *ast.Spread:
+		return evaluateSpread(node, scope)
+	case *ast.Super:
+		return evaluateSuper(node, scope)
 	case *ast.Switch:
 		return evaluateSwitch(node, scope)
 	case *ast.Ternary:
@@ -80,10 +100,16 @@ func Evaluate(node ast.Node, scope *object.Scope) object.Object {
 		return evaluateTrait(node, scope)
 	case *ast.This:
 		return evaluateThis(node, scope)
+	case *ast.Throw:
+		return evaluateThrow(node, scope)
+	case *ast.Try:
+		return evaluateTry(node, scope)
 	case *ast.Use:
 		return evaluateUse(node, scope)
 	case *ast.While:
 		return evaluateWhile(node, scope)
+	case *ast.Yield:
+		return evaluateYield(node, scope)
 	}
 
 	return nil
@@ -102,6 +128,16 @@ func toBooleanValue(input bool) *object.Boolean {
 	return value.FALSE
 }
 
+// traceError records the call an error propagated out of, so the error can be
+// reported along with the stack of calls that led to it.
+func traceError(result object.Object, function string, tok token.Token) object.Object {
+	if err, ok := result.(*object.Error); ok {
+		err.AddFrame(function, tok)
+	}
+
+	return result
+}
+
 // isError determines if the referenced object is an error.
 func isError(obj object.Object) bool {
 	if obj != nil {
@@ -111,10 +147,11 @@ func isError(obj object.Object) bool {
 	return false
 }
 
-// isTerminator determines if the referenced object is an error, break, or continue.
+// isTerminator determines if the referenced object is an error, break,
+// continue, or return.
 func isTerminator(obj object.Object) bool {
 	if obj != nil {
-		return obj.Type() == object.ERROR || obj.Type() == object.BREAK || obj.Type() == object.CONTINUE
+		return obj.Type() == object.ERROR || obj.Type() == object.BREAK || obj.Type() == object.CONTINUE || obj.Type() == object.RETURN
 	}
 
 	return false
@@ -134,7 +171,35 @@ func isTruthy(value object.Object) bool {
 	}
 }
 
+// newBlockScope creates a scope for bindings local to a single block, such as
+// loop variables, enclosed by the referenced scope.
+func newBlockScope(scope *object.Scope) *object.Scope {
+	return &object.Scope{
+		Self:        scope.Self,
+		Environment: object.NewBlockEnvironment(scope.Environment),
+		Class:       scope.Class,
+		Coroutine:   scope.Coroutine,
+	}
+}
+
 // newError returns a new error object.
-func newError(format string, a ...interface{}) *object.Error {
-	return &object.Error{Message: fmt.Sprintf(format, a...)}
+func newError(kind string, tok token.Token, format string, a ...interface{}) *object.Error {
+	return object.NewErrorAt(kind, tok, format, a...)
+}
+
+// SyntaxError returns an error object for the first of the syntax errors
+// found while parsing, with the messages of all of them as its data.
+func SyntaxError(diagnostics []*parser.Diagnostic) *object.Error {
+	first := diagnostics[0]
+	messages := make([]object.Object, len(diagnostics))
+
+	for index, diagnostic := range diagnostics {
+		messages[index] = &object.String{Value: diagnostic.String()}
+	}
+
+	err := object.NewErrorAt(object.SyntaxError, token.Token{Line: first.Line, Column: first.Column, File: first.File}, "%s", first.Reason)
+	err.Message = first.String()
+	err.Data = &object.List{Elements: messages}
+
+	return err
 }
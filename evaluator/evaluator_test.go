@@ -1,12 +1,17 @@
 package evaluator
 
 import (
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 
+	"ghostlang.org/x/ghost/library"
 	"ghostlang.org/x/ghost/library/modules"
 	"ghostlang.org/x/ghost/object"
 	"ghostlang.org/x/ghost/parser"
 	"ghostlang.org/x/ghost/scanner"
+	"ghostlang.org/x/ghost/token"
 )
 
 func TestErrorHandling(t *testing.T) {
@@ -17,6 +22,21 @@ func TestErrorHandling(t *testing.T) {
 		{"5 + true", "1:3:test.ghost: runtime error: type mismatch: NUMBER + BOOLEAN"},
 		{"5 + true; 5", "1:3:test.ghost: runtime error: type mismatch: NUMBER + BOOLEAN"},
 		{"-true", "1:1:test.ghost: runtime error: unknown operator: -BOOLEAN"},
+		{"~1.5", "1:1:test.ghost: runtime error: unknown operator: ~NUMBER"},
+		{"1.5 & 1", "1:5:test.ghost: runtime error: bitwise operator & requires integer operands"},
+		{"1 << -1", "1:3:test.ghost: runtime error: negative shift count: -1"},
+		{"1 << 64", "1:3:test.ghost: runtime error: shift count too large: 64"},
+		{"1 >> 70", "1:3:test.ghost: runtime error: shift count too large: 70"},
+		{"x = 1; x <<= 64", "1:10:test.ghost: runtime error: shift count too large: 64"},
+		{"1 += 2", "1:3:test.ghost: runtime error: cannot assign to 1"},
+		{"map = null; map?.a += 1", "1:20:test.ghost: runtime error: cannot assign to map?.a"},
+		{"map = null; map.a += 1", "1:16:test.ghost: runtime error: cannot access property a on NULL"},
+		{"map = null; map?.a++", "1:18:test.ghost: runtime error: cannot assign to map?.a"},
+		{`map = {"a": "b"}; map.a++`, "1:23:test.ghost: runtime error: map.a is not a number"},
+		{"class Counter { }; Counter.new().count++", "1:33:test.ghost: runtime error: undefined property count for class Counter"},
+		{"1 ~/ 0", "1:3:test.ghost: runtime error: division by zero"},
+		{"(1..3).contains()", "1:7:test.ghost: runtime error: range.contains() expects 1 argument. got=0"},
+		{"trait Named { }; class Dog { }; Dog.implements(Dog)", "1:36:test.ghost: runtime error: implements() expects a trait. got=CLASS"},
 		{"true + false", "1:6:test.ghost: runtime error: unknown operator: BOOLEAN + BOOLEAN"},
 		{"5; true + false; 5", "1:9:test.ghost: runtime error: unknown operator: BOOLEAN + BOOLEAN"},
 		{"if (10 > 1) { if (10 > 1) { return true + false } return 1 }", "1:41:test.ghost: runtime error: unknown operator: BOOLEAN + BOOLEAN"},
@@ -79,6 +99,42 @@ func TestNumbers(t *testing.T) {
 		{"x = 10; x /= 2; x", 5},
 		{"x = 0; x++; x", 1},
 		{"x = 6; x--; x", 5},
+		{"x = 1; y = x++; y", 1},
+		{"x = 1; y = x++ + 10; x + y", 13},
+		{"x = 5; y = x-- * 2; y", 10},
+		{"i = 0; n = 0; while (i++ < 3) { n += 1 }; n", 3},
+		{"class Counter { function constructor() { this.i = 0 }; function tick() { return this.i++ } }; c = Counter.new(); c.tick(); c.tick() * 10 + c.i", 12},
+		{`m = {"a": 1}; old = m.a++; old * 10 + m.a`, 12},
+		{`m = {"a": 5}; m.a--; m.a`, 4},
+		{"list = [1, 2]; old = list[1]++; old * 10 + list[1]", 23},
+		{"list = [1, 2]; calls = []; function at() { calls.push(1); return 0 }; list[at()]++; list[0] * 10 + calls.length()", 21},
+		{"x = 2; x *= 1 + 2; x", 6},
+		{"2 ** 10", 1024},
+		{"2 ** 3 ** 2", 512},
+		{"-2 ** 2", -4},
+		{"7 ~/ 2", 3},
+		{"-7 ~/ 2", -4},
+		{"6 & 3", 2},
+		{"6 | 3", 7},
+		{"6 ^ 3", 5},
+		{"~5", -6},
+		{"1 << 4", 16},
+		{"256 >> 2", 64},
+		{"1 + 1 << 2", 8},
+		{"x = 3; x **= 2; x", 9},
+		{"x = 9; x %= 4; x", 1},
+		{"x = 9; x ~/= 2; x", 4},
+		{"x = 12; x &= 10; x", 8},
+		{"x = 12; x |= 3; x", 15},
+		{"x = 12; x ^= 4; x", 8},
+		{"x = 1; x <<= 3; x", 8},
+		{"1 << 63 >> 63", -1},
+		{"list = [1, 2]; list[1] += 5; list[1]", 7},
+		{"map = {\"a\": 2}; map[\"a\"] **= 3; map.a", 8},
+		{"map = {\"a\": 9}; map.a ~/= 2; map.a", 4},
+		{"class Point { function constructor() { this.x = 1 } }; p = Point.new(); p.x |= 6; p.x", 7},
+		{"calls = []; f = () => { calls.push(1); return 0 }; list = [1]; list[f()] += 1; calls.length()", 1},
+		{"x = 8; x >>= 3; x", 1},
 	}
 
 	for _, tt := range tests {
@@ -153,25 +209,79 @@ func TestForInExpressions(t *testing.T) {
 func TestRangeExpressions(t *testing.T) {
 	tests := []struct {
 		input    string
-		expected interface{}
+		expected []int64
 	}{
-		{`1 .. 0`, []int{}},
-		{`-1 .. 0`, []int{-1, 0}},
-		{`1 .. 1`, []int{1}},
-		{`1 .. 5`, []int{1, 2, 3, 4, 5}},
+		{`1 .. 0`, []int64{1, 0}},
+		{`-1 .. 0`, []int64{-1, 0}},
+		{`1 .. 1`, []int64{1}},
+		{`1 .. 5`, []int64{1, 2, 3, 4, 5}},
+		{`5 .. 1`, []int64{5, 4, 3, 2, 1}},
+		{`1 .. 10 step 3`, []int64{1, 4, 7, 10}},
+		{`10 .. 1 step 4`, []int64{10, 6, 2}},
+		{`1 .. 2 step 5`, []int64{1}},
 	}
 
 	for _, tt := range tests {
 		result := evaluate(tt.input)
 
-		list, ok := result.(*object.List)
+		rangeObject, ok := result.(*object.Range)
 
 		if !ok {
-			t.Errorf("object not List. got=%T (+%v)", result, result)
+			t.Fatalf("object not Range. got=%T (+%v)", result, result)
+		}
+
+		if rangeObject.Length() != int64(len(tt.expected)) {
+			t.Errorf("wrong number of elements. wanted=%d, got=%d", len(tt.expected), rangeObject.Length())
 		}
 
-		if len(list.Elements) != len(tt.expected.([]int)) {
-			t.Errorf("wrong number of elements. wanted=%d, got=%d", len(tt.expected.([]int)), len(list.Elements))
+		list := iterableToList(rangeObject).(*object.List)
+
+		for index, expected := range tt.expected {
+			isNumberObject(t, list.Elements[index], expected)
+		}
+	}
+}
+
+func TestRangeOperations(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`(1..10).length()`, 10},
+		{`(1..10 step 2).length()`, 5},
+		{`(1..10)[3]`, 4},
+		{`(10..1)[3]`, 7},
+		{`(1..10)[10]`, nil},
+		{`(1..10 step 3).last()`, 10},
+		{`(5..1).first()`, 5},
+		{`(1..10 step 3).contains(7)`, true},
+		{`(1..10 step 3).contains(8)`, false},
+		{`(1..10).contains(11)`, false},
+		{`(10..1).contains(3)`, true},
+		{`(1..5).toList().length()`, 5},
+		{`(1..10 step 2).step`, 2},
+		{`(1..10).end`, 10},
+		{`total = 0; for (i in 1..100) { total += i }; total`, 5050},
+		{`total = 0; for (k, v in 3..1) { total += k }; total`, 3},
+		{`[...1..3, 4].length()`, 4},
+		{`step = 2; step`, 2},
+		{`1.."a"`, "1:2:test.ghost: runtime error: range bounds must be numbers, got NUMBER..STRING"},
+		{`1..5 step 0`, "1:2:test.ghost: runtime error: range step must be a positive number, got 0"},
+		{`1..5 step -1`, "1:2:test.ghost: runtime error: range step must be a positive number, got -1"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			isNumberObject(t, result, int64(expected))
+		case bool:
+			isBooleanObject(t, result, expected)
+		case string:
+			isErrorObject(t, result, expected)
+		default:
+			isNullObject(t, result)
 		}
 	}
 }
@@ -223,6 +333,969 @@ func TestClassProperties(t *testing.T) {
 	isNumberObject(t, result, 314)
 }
 
+func TestTryExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`try { 1 } catch (err) { 2 }`, 1},
+		{`try { 5 + true } catch (err) { 2 }`, 2},
+		{`try { 5 + true } catch { 3 }`, 3},
+		{`try { throw "oops" } catch (err) { err.line }`, 1},
+		{`try { throw "oops" } catch (err) { err.column }`, 7},
+		{`x = 0; try { throw "oops" } catch (err) { x = 1 } finally { x = x + 10 }; x`, 11},
+		{`x = 0; try { x = 1 } finally { x = x + 10 }; x`, 11},
+		{`function foo() { throw "oops" } try { foo() } catch (err) { err.line }`, 1},
+		{`try { throw "oops" } finally { 1 }`, "1:7:test.ghost: runtime error: oops"},
+		{`try { throw "oops" } catch (err) { throw err }`, "1:7:test.ghost: runtime error: oops"},
+		{`try { 1 } catch (err) { 2 } finally { 1 + true }`, "1:41:test.ghost: runtime error: type mismatch: NUMBER + BOOLEAN"},
+		{`throw 1`, "1:1:test.ghost: runtime error: throw expects a string, map or exception, got NUMBER"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+		number, ok := tt.expected.(int)
+
+		if ok {
+			isNumberObject(t, result, int64(number))
+		} else {
+			isErrorObject(t, result, tt.expected.(string))
+		}
+	}
+}
+
+func TestExceptionProperties(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`try { throw "oops" } catch (err) { err.message }`, "oops"},
+		{`try { throw "oops" } catch (err) { err.file }`, "test.ghost"},
+		{`try { 5 + true } catch (err) { err.message }`, "type mismatch: NUMBER + BOOLEAN"},
+		{`try { throw "oops" } catch (err) { type(err) }`, "exception"},
+		{`try { throw "oops" } catch (err) { err.kind }`, "RuntimeError"},
+		{`try { 5 + true } catch (err) { err.kind }`, "TypeError"},
+		{`try { missing } catch (err) { err.kind }`, "NameError"},
+		{`try { 1 ~/ 0 } catch (err) { err.kind }`, "ArithmeticError"},
+		{`try { json.decode(1) } catch (err) { err.kind }`, "TypeError"},
+		{`try { "[".matches("a") } catch (err) { err.kind }`, "ArgumentError"},
+		{`try { "[".matches("a") } catch (err) { err.message }`, "string.matches() expects a valid regular expression: error parsing regexp: missing closing ]: `[`"},
+		{`try { (1..3).contains() } catch (err) { err.kind }`, "ArgumentError"},
+		{`try { (1..3).contains() } catch (err) { err.message }`, "range.contains() expects 1 argument. got=0"},
+		{`class Dog { }; try { Dog.implements(1) } catch (err) { err.kind }`, "TypeError"},
+		{`class Dog { }; try { Dog.new().implements() } catch (err) { err.kind }`, "ArgumentError"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		isStringObject(t, result, tt.expected)
+	}
+}
+
+func TestInterpolation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`"plain"`, "plain"},
+		{`name = "Ghost"; "Hello ${name}!"`, "Hello Ghost!"},
+		{`count = 2; "${count + 1} items"`, "3 items"},
+		{`user = {name: "Kai"}; "${user.name}"`, "Kai"},
+		{`"${"inner ${1 + 1}"}"`, "inner 2"},
+		{`"${null}"`, "null"},
+		{`'${raw}'`, "${raw}"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		isStringObject(t, result, tt.expected)
+	}
+
+	isErrorObject(t, evaluate(`"${1 + true}"`), "1:6:test.ghost: runtime error: type mismatch: NUMBER + BOOLEAN")
+}
+
+func TestSuperMethods(t *testing.T) {
+	classes := `
+	class Shape {
+		function constructor(sides) {
+			this.sides = sides
+		}
+
+		function area() {
+			return 1
+		}
+	}
+
+	class Square extends Shape {
+		function constructor(size) {
+			super.constructor(4)
+			this.size = size
+		}
+
+		function area() {
+			return super.area() * this.size * this.size
+		}
+	}
+
+	class Cube extends Square {
+		function area() {
+			return super.area() * 6
+		}
+	}
+	`
+
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{classes + `Square.new(3).sides`, 4},
+		{classes + `Square.new(3).area()`, 9},
+		{classes + `Cube.new(2).area()`, 24},
+		{classes + `Cube.new(2).sides`, 4},
+		{`class Foo { function bar() { super.bar() } } Foo.new().bar()`, "1:30:test.ghost: runtime error: class Foo does not extend a parent class"},
+		{`function foo() { super.bar() } foo()`, "1:18:test.ghost: runtime error: super can only be used within a class method"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+		number, ok := tt.expected.(int)
+
+		if ok {
+			isNumberObject(t, result, int64(number))
+		} else {
+			isErrorObject(t, result, tt.expected.(string))
+		}
+	}
+}
+
+func TestArrowFunctions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{`add = (a, b) => a + b; add(2, 3)`, 5},
+		{`double = x => x * 2; double(21)`, 42},
+		{`increment = (x, by = 1) => x + by; increment(1)`, 2},
+		{`(() => { return 7 })()`, 7},
+		{`factor = 3; scale = x => x * factor; factor = 4; scale(2)`, 8},
+		{`function adder(a) { return b => a + b } adder(1)(2)`, 3},
+		{`class Foo { function constructor() { this.a = 5 } function get() { f = () => this.a; return f() } } Foo.new().get()`, 5},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		isNumberObject(t, result, tt.expected)
+	}
+}
+
+func TestLoopClosures(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`fns = []; for (i = 0; i < 3; i++) { fns.push(() => i) }; fns[1]()`, 1},
+		{`fns = []; for (x in [10, 20, 30]) { fns.push(() => x) }; fns[2]()`, 30},
+		{`fns = []; for (k, v in [10, 20]) { fns.push(() => k) }; fns[0]()`, 0},
+		{`total = 0; for (x in [1, 2, 3]) { total = total + x }; total`, 6},
+		{`n = 0; for (i = 0; i < 10; i++) { if (i == 1) { i = 8 } n = n + 1 }; n`, 3},
+		{`i = 99; for (i = 0; i < 3; i++) { }; i`, 99},
+		{`for (i = 0; i < 3; i++) { }; i`, "1:30:test.ghost: runtime error: unknown identifier: i"},
+		{`for (x in [1]) { }; x`, "1:21:test.ghost: runtime error: unknown identifier: x"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+		number, ok := tt.expected.(int)
+
+		if ok {
+			isNumberObject(t, result, int64(number))
+		} else {
+			isErrorObject(t, result, tt.expected.(string))
+		}
+	}
+}
+
+func TestDestructuring(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`[a, b] = [1, 2]; a + b`, 3},
+		{`[a, b, c] = [1, 2]; c`, nil},
+		{`[first, ...rest] = [1, 2, 3]; rest.length()`, 2},
+		{`[first, ...rest] = [1]; rest.length()`, 0},
+		{`[a, [b, c]] = [1, [2, 3]]; c`, 3},
+		{`a = 1; b = 2; [a, b] = [b, a]; a`, 2},
+		{`{name, age: years} = {"name": "Kai", "age": 30}; years`, 30},
+		{`{missing} = {}; missing`, nil},
+		{`{point: [x, y]} = {"point": [4, 5]}; y`, 5},
+		{`m = {}; [m.a, m["b"]] = [1, 2]; m.b`, 2},
+		{`total = 0; for (key, [a, b] in {"x": [1, 2], "y": [3, 4]}) { total = total + a * b }; total`, 14},
+		{`total = 0; for ({value} in [{"value": 5}, {"value": 6}]) { total = total + value }; total`, 11},
+		{`for ([a, b] in [[1, 2]]) { }; a`, "1:31:test.ghost: runtime error: unknown identifier: a"},
+		{`[a, b] = 5`, "1:1:test.ghost: runtime error: cannot destructure NUMBER as a list"},
+		{`{a} = [1]`, "1:1:test.ghost: runtime error: cannot destructure LIST as a map"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			isNumberObject(t, result, int64(expected))
+		case string:
+			isErrorObject(t, result, expected)
+		default:
+			isNullObject(t, result)
+		}
+	}
+}
+
+func TestRestParameters(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`function count(...items) { return items.length() }; count()`, 0},
+		{`function count(...items) { return items.length() }; count(1, 2, 3)`, 3},
+		{`function log(level, ...messages) { return messages.length() }; log("info", "a", "b")`, 2},
+		{`function log(level, ...messages) { return messages.length() }; log("info")`, 0},
+		{`first = (head, ...tail) => tail[0]; first(1, 2, 3)`, 2},
+		{`class Sum { function of(...numbers) { total = 0; for (n in numbers) { total = total + n }; return total } }; Sum.new().of(1, 2, 3)`, 6},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		isNumberObject(t, result, int64(tt.expected.(int)))
+	}
+}
+
+func TestSpreadExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`add = (a, b, c) => a + b + c; args = [1, 2, 3]; add(...args)`, 6},
+		{`add = (a, b, c) => a + b + c; add(1, ...[2, 3])`, 6},
+		{`a = [1, 2]; b = [3]; [...a, ...b, 4].length()`, 4},
+		{`a = [1, 2]; b = [...a, 3]; b[2]`, 3},
+		{`defaults = {"size": 1, "color": 2}; overrides = {"size": 3}; {...defaults, ...overrides}.size`, 3},
+		{`defaults = {"size": 1}; {...defaults, "size": 2}.size`, 2},
+		{`defaults = {"size": 1}; {"size": 2, ...defaults}.size`, 1},
+		{`print(...5)`, "1:7:test.ghost: runtime error: cannot spread NUMBER into a list"},
+		{`{...[1]}`, "1:2:test.ghost: runtime error: cannot spread LIST into a map"},
+		{`...[1]`, "1:1:test.ghost: runtime error: spread syntax is only allowed in calls, lists and maps"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+		number, ok := tt.expected.(int)
+
+		if ok {
+			isNumberObject(t, result, int64(number))
+		} else {
+			isErrorObject(t, result, tt.expected.(string))
+		}
+	}
+}
+
+func TestNamedArguments(t *testing.T) {
+	library.RegisterNamedFunction("timeout", func(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
+		named := args[len(args)-1].(*object.Map)
+		key := &object.String{Value: "timeout"}
+
+		return named.Pairs[key.MapKey()].Value
+	})
+
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`function f(a = 1, b = 2, c = 3) { return a * 100 + b * 10 + c }; f(c: 5)`, 125},
+		{`function f(a = 1, b = 2, c = 3) { return a * 100 + b * 10 + c }; f(4, c: 5, b: 6)`, 465},
+		{`f = (a, b = 2) => a - b; f(b: 1, a: 10)`, 9},
+		{`class Point { function constructor(x = 0, y = 0) { this.x = x; this.y = y } }; Point.new(y: 7).y`, 7},
+		{`class Point { function constructor(x = 0, y = 0) { this.x = x; this.y = y } }; Point.new(y: 7).x`, 0},
+		{`class Counter { function add(by = 1, times = 1) { return by * times } }; Counter.new().add(times: 3)`, 3},
+		{`m = {"f": (a = 1, b = 2) => b}; m.f(b: 4)`, 4},
+		{`timeout(timeout: 30)`, 30},
+		{`function f(a) { return a }; f(b: 1)`, "1:30:test.ghost: runtime error: unknown named argument: b"},
+		{`function f(a) { return a }; f(1, a: 2)`, "1:30:test.ghost: runtime error: multiple values for argument: a"},
+		{`print(message: 1)`, "1:6:test.ghost: runtime error: print does not accept named arguments"},
+		{`[].push(value: 1)`, "1:3:test.ghost: runtime error: LIST methods do not accept named arguments"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+		number, ok := tt.expected.(int)
+
+		if ok {
+			isNumberObject(t, result, int64(number))
+		} else {
+			isErrorObject(t, result, tt.expected.(string))
+		}
+	}
+}
+
+func TestNullSafeOperators(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`response = {"body": {}}; response.body.user?.name`, nil},
+		{`response = {"body": {"user": {"age": 5}}}; response?.body?.user?.age`, 5},
+		{`list = null; list?.length()`, nil},
+		{`list = null; list?.[0]`, nil},
+		{`list = [4]; list?.[0]`, 4},
+		{`list = null; list?.length() ?? 0`, 0},
+		{`null ?? 1`, 1},
+		{`0 ?? 1`, 0},
+		{`null ?? null ?? 2`, 2},
+		{`calls = []; f = () => { calls.push(1) }; 1 ?? f(); calls.length()`, 0},
+		{`calls = []; f = () => { calls.push(1) }; null ?? f(); calls.length()`, 1},
+		{`response = null; response?.body.user.name`, nil},
+		{`response = {"body": null}; response.body?.user.name.length()`, nil},
+		{`response = null; response?.body["user"].name`, nil},
+		{`response = null; response?.handler()(1).name`, nil},
+		{`calls = []; f = () => { calls.push(1) }; response = null; response?.body[f()].name; calls.length()`, 0},
+		{`response = null; name = response?.body.user; name ?? 3`, 3},
+		{`response = {"body": null}; response?.body.user`, "1:42:test.ghost: runtime error: cannot access property user on NULL"},
+		{`response = {"body": {}}; response.body.user.name`, "1:44:test.ghost: runtime error: cannot access property name on NULL"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			isNumberObject(t, result, int64(expected))
+		case string:
+			isErrorObject(t, result, expected)
+		default:
+			isNullObject(t, result)
+		}
+	}
+}
+
+func TestDeclarations(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`let x = 5; x`, 5},
+		{`let x; x`, nil},
+		{`const x = 5; x`, 5},
+		{`let x = 1; if (true) { let x = 2 }; x`, 1},
+		{`let x = 1; if (true) { x = 2 }; x`, 2},
+		{`if (true) { y = 2 }; y`, 2},
+		{`let [a, b] = [1, 2]; b`, 2},
+		{`const {size} = {"size": 3}; size`, 3},
+		{`fns = []; for (i in [1, 2]) { let j = i * 10; fns.push(() => j) }; fns[0]()`, 10},
+		{`const x = 1; if (true) { const x = 2 }; x`, 1},
+		{`total = 0; i = 0; while (i < 3) { let x = i; total += x; i++ }; total`, 3},
+		{`count = 0; for (i = 0; i < 3; i++) { let i = 10; count += 1 }; count`, 3},
+		{`total = 0; for (x in [1, 2]) { let x = x * 10; total += x }; total`, 30},
+		{`for (i = 0; i < 2; i++) { let y = i }; y`, "1:40:test.ghost: runtime error: unknown identifier: y"},
+		{`try { throw "boom" } catch (e) { let e = 1; e }`, 1},
+		{`if (true) { let z = 1 }; z`, "1:26:test.ghost: runtime error: unknown identifier: z"},
+		{`const x = 1; x = 2`, "1:14:test.ghost: runtime error: cannot reassign constant: x"},
+		{`const x = 1; x += 2`, "1:14:test.ghost: runtime error: cannot reassign constant: x"},
+		{`const x = 1; x++`, "1:14:test.ghost: runtime error: cannot reassign constant: x"},
+		{`const x = 1; function f() { x = 2 }; f()`, "1:29:test.ghost: runtime error: cannot reassign constant: x"},
+		{`const [a, b] = [1, 2]; [a] = [3]`, "1:25:test.ghost: runtime error: cannot reassign constant: a"},
+		{`const x = 1; const x = 2`, "1:20:test.ghost: runtime error: cannot redeclare variable: x"},
+		{`const x = 1; let x = 5`, "1:18:test.ghost: runtime error: cannot redeclare variable: x"},
+		{`let x = 1; let x = 5`, "1:16:test.ghost: runtime error: cannot redeclare variable: x"},
+		{`let x = 1; const x = 5`, "1:18:test.ghost: runtime error: cannot redeclare variable: x"},
+		{`const x = 1; if (true) { let x = 2; let x = 3 }`, "1:41:test.ghost: runtime error: cannot redeclare variable: x"},
+		{`let [a, a] = [1, 2]`, "1:9:test.ghost: runtime error: cannot redeclare variable: a"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			isNumberObject(t, result, int64(expected))
+		case string:
+			isErrorObject(t, result, expected)
+		default:
+			isNullObject(t, result)
+		}
+	}
+}
+
+func TestGenerators(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`function count(n) { for (i = 0; i < n; i++) { yield i } }; total = 0; for (x in count(5)) { total += x }; total`, 10},
+		{`function count(n) { for (i = 0; i < n; i++) { yield i } }; total = 0; for (k, v in count(3)) { total += k }; total`, 3},
+		{`function three() { yield 1; yield 2; yield 3 }; three().toList().length()`, 3},
+		{`function one() { yield 1 }; g = one(); g.next(); g.next()["done"]`, true},
+		{`function one() { yield 1 }; g = one(); g.next()["value"]`, 1},
+		{`function naturals() { n = 0; while (true) { n += 1; yield n } }; last = 0; for (n in naturals()) { if (n > 4) { break }; last = n }; last`, 4},
+		{`function naturals() { n = 0; while (true) { n += 1; yield n } }; function find() { for (n in naturals()) { if (n == 7) { return n } } }; find()`, 7},
+		{`double = (list) => { for (x in list) { yield x * 2 } }; double([1, 2, 3]).toList()[2]`, 6},
+		{`function bad() { yield 1; throw "boom" }; for (x in bad()) { }`, "1:27:test.ghost: runtime error: boom"},
+		{`closed = []; function gen() { try { yield 1; yield 2 } finally { closed.push(true) } }; for (x in gen()) { break }; closed.length()`, 1},
+		{`closed = []; function gen() { try { yield 1; yield 2 } finally { closed.push(true) } }; g = gen(); g.next(); g.close(); closed.length()`, 1},
+		{`function two() { yield 1; yield 2 }; g = two(); g.next(); g.close(); g.next()["done"]`, true},
+		{`function two() { yield 1; yield 2 }; g = two(); g.close(); g.next()["done"]`, true},
+		{`g = null; function gen() { yield 1; g.next(); yield 2 }; g = gen(); g.next(); g.next()`, "1:38:test.ghost: runtime error: generator is already running"},
+		{`g = null; function gen() { yield 1; g.close(); yield 2 }; g = gen(); g.next(); g.next()`, "1:38:test.ghost: runtime error: generator is already running"},
+		{`g = null; function gen() { yield 1; for (x in g) { } }; g = gen(); g.next(); g.next()`, "1:37:test.ghost: runtime error: generator is already running"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			isNumberObject(t, result, int64(expected))
+		case bool:
+			isBooleanObject(t, result, expected)
+		case string:
+			isErrorObject(t, result, expected)
+		}
+	}
+}
+
+func TestAbandonedGeneratorsAreReleased(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	result := evaluate(`function numbers() { n = 0; while (true) { n += 1; yield n } }; for (i in 1..1000) { numbers().next() }`)
+
+	if isError(result) {
+		t.Fatalf("evaluating abandoned generators failed: %s", result.String())
+	}
+
+	// Collecting a generator takes one cycle to run its finalizer, which ends
+	// the goroutine, and another to free it
+	for attempt := 0; attempt < 100 && runtime.NumGoroutine() > before; attempt++ {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("abandoned generators were not released. goroutines before=%d, after=%d", before, after)
+	}
+}
+
+func TestIteratorProtocol(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`class Countdown { function constructor(start) { this.start = start }; function iterator() { for (i = this.start; i > 0; i--) { yield i } } }; total = 0; for (x in Countdown.new(4)) { total += x }; total`, 10},
+		{`class Steps { function constructor() { this.n = 0 }; function next() { this.n = this.n + 1; if (this.n > 3) { return {"done": true} }; return {"value": this.n, "done": false} } }; total = 0; for (x in Steps.new()) { total += x }; total`, 6},
+		{`class Steps { function constructor() { this.n = 0 }; function next() { this.n = this.n + 1; return {"value": this.n, "done": this.n > 2} } }; class Wrapper { function iterator() { return Steps.new() } }; total = 0; for (x in Wrapper.new()) { total += x }; total`, 3},
+		{`class Empty { }; for (x in Empty.new()) { }`, "1:18:test.ghost: runtime error: unusable as for loop: class Empty does not define iterator() or next()"},
+		{`class Broken { function next() { return 5 } }; for (x in Broken.new()) { }`, "1:48:test.ghost: runtime error: next() of class Broken must return a map, got NUMBER"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			isNumberObject(t, result, int64(expected))
+		case string:
+			isErrorObject(t, result, expected)
+		}
+	}
+}
+
+func TestReturnWithinLoops(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{`function f() { for (i = 0; i < 10; i++) { if (i == 3) { return i } }; return -1 }; f()`, 3},
+		{`function f() { for (x in [4, 5, 6]) { if (x == 5) { return x } }; return -1 }; f()`, 5},
+		{`function f() { i = 0; while (true) { i += 1; if (i == 8) { return i } } }; f()`, 8},
+		{`function f() { for (k, v in {"a": 1}) { return v }; return -1 }; f()`, 1},
+		{`function f() { for (x in 1..10) { for (y in 1..10) { if (x * y == 6) { return x * 10 + y } } }; return -1 }; f()`, 16},
+		{`function f() { total = 0; for (x in [1, 2, 3]) { g = () => { return x }; total += g() }; return total }; f()`, 6},
+		{`function f() { i = 0; while (i < 5) { i++; if (i == 2) { return i * 100 } }; return -1 }; f()`, 200},
+	}
+
+	for _, tt := range tests {
+		isNumberObject(t, evaluate(tt.input), tt.expected)
+	}
+}
+
+func TestMatchExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`match (1) { case 1 { "one" } case 2 { "two" } }`, "one"},
+		{`match (-3) { case -3 { "minus three" } default { "other" } }`, "minus three"},
+		{`match (5) { case 1..3 { "low" } case 4..6 { "mid" } }`, "mid"},
+		{`match ("b") { case "a", "b" { "letter" } }`, "letter"},
+		{`match (7) { case 1 { "one" } default { "other" } }`, "other"},
+		{`match (7) { case 1 { "one" } }`, nil},
+		{`match (7) { case n if n > 10 { "big" } case n { "small" } }`, "small"},
+		{`match ([1, 2, 3]) { case [a, b] { "pair" } case [a, ...rest] { rest.length() } }`, 2},
+		{`match ([]) { case [] { "empty" } }`, "empty"},
+		{`match ([1, [2, 3]]) { case [1, [_, c]] { c } }`, 3},
+		{`match ({"type": "user", "name": "ada"}) { case {"type": "admin"} { "admin" } case {"type": "user", name} { name } }`, "ada"},
+		{`match ({"a": 1}) { case {"b": _} { "b" } default { "none" } }`, "none"},
+		{`class Point { function constructor(x) { this.x = x } }; match (Point.new(4)) { case Point({x}) { x } }`, 4},
+		{`class Shape { }; class Circle extends Shape { }; match (Circle.new()) { case Shape(shape) { "shape" } }`, "shape"},
+		{`class Shape { }; class Circle { }; match (Circle.new()) { case Shape() { "shape" } default { "other" } }`, "other"},
+		{`result = match (2) { case 2 { "two" } }; result`, "two"},
+		{`n = 1; match (5) { case n { n } }; n`, 1},
+		{`x = 5; match (1) { case x { "bound" } }`, "bound"},
+		{`total = 0; for (i in 1..5) { match (i) { case 2 { continue } case 4 { break } }; total += i }; total`, 4},
+		{`notClass = 5; match (1) { case notClass(x) { x } }`, "1:40:test.ghost: runtime error: match pattern expects a class, got NUMBER"},
+		{`class Account { function constructor() { this.#pin = 1234 } }; match (Account.new()) { case {"#pin": pin} { pin } }`, "1:93:test.ghost: runtime error: cannot access private property #pin outside of class Account"},
+		{`class Account { function constructor() { this.#pin = 1234 }; function check() { return match (this) { case {"#pin": pin} { pin } } } }; Account.new().check()`, 1234},
+		{`class Box { function constructor() { this.#size = 3 }; get size() { return this.#size * 2 } }; match (Box.new()) { case {size} { size } }`, 6},
+		{`class Box { }; match (Box.new()) { case {size} { size } default { "empty" } }`, "empty"},
+		{`match = (text) => text + "!"; matches = [match("a")]; result = ""; for (i, match in matches) { result = match }; result`, "a!"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			isNumberObject(t, result, int64(expected))
+		case string:
+			if isError(result) {
+				isErrorObject(t, result, expected)
+			} else {
+				isStringObject(t, result, expected)
+			}
+		default:
+			isNullObject(t, result)
+		}
+	}
+}
+
+func TestEnums(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`enum Color { Red, Green, Blue }; Color.Blue.value`, 2},
+		{`enum Color { Red, Green, Blue }; Color.Green.name`, "Green"},
+		{`enum Color { Red, Green, Blue }; Color.Red.toString()`, "Color.Red"},
+		{`enum Color { Red, Green, Blue }; Color.values().length()`, 3},
+		{`enum Color { Red, Green, Blue }; Color.values()[1] == Color.Green`, true},
+		{`enum Color { Red, Green }; Color.Red == Color.Green`, false},
+		{`enum Color { Red, Green }; Color.Red != Color.Green`, true},
+		{`enum Status { Ok(code: 200), Missing(code: 404) }; Status.Missing.code`, 404},
+		{`enum Color { Red, Green }; names = {Color.Red: "red", Color.Green: "green"}; names[Color.Green]`, "green"},
+		{`function palette() { enum Color { Red }; return Color }; A = palette(); B = palette(); A.Red == B.Red`, false},
+		{`function palette() { enum Color { Red }; return Color }; A = palette(); B = palette(); names = {}; names[A.Red] = "a"; names[B.Red] = "b"; names[A.Red]`, "a"},
+		{`function palette() { enum Color { Red }; return Color }; A = palette(); B = palette(); names = {A.Red: 1, B.Red: 2}; names[A.Red] * 10 + names[B.Red]`, 12},
+		{`enum Color { Red, Green }; switch (Color.Green) { case Color.Red { "red" } case Color.Green { "green" } }`, "green"},
+		{`enum Color { Red, Green }; match (Color.Red) { case Color.Green { "green" } case Color.Red { "red" } }`, "red"},
+		{`enum Color { Red }; Color.Purple`, "1:26:test.ghost: runtime error: unknown member: Color.Purple"},
+		{`enum Color { Red }; Color.Red.shade`, "1:30:test.ghost: runtime error: unknown property: Color.Red.shade"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			isNumberObject(t, result, int64(expected))
+		case bool:
+			isBooleanObject(t, result, expected)
+		case string:
+			if isError(result) {
+				isErrorObject(t, result, expected)
+			} else {
+				isStringObject(t, result, expected)
+			}
+		}
+	}
+}
+
+func TestStaticMembers(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`class Config { static retries = 3 }; Config.retries`, 3},
+		{`class Config { static retries }; Config.retries`, nil},
+		{`class Config { static retries = 3 }; Config.retries = 5; Config.retries`, 5},
+		{`class Math { static function double(x) { return x * 2 } }; Math.double(4)`, 8},
+		{`class Math { static function scale(x, by = 2) { return x * by } }; Math.scale(4, by: 3)`, 12},
+		{`class User { static function create(name) { return this.new(name) }; function constructor(name) { this.name = name } }; User.create("ada").name`, "ada"},
+		{`class Base { static limit = 10 }; class Child extends Base { }; Child.limit`, 10},
+		{`class Base { static function kind() { return "base" } }; class Child extends Base { }; Child.kind()`, "base"},
+		{`class Base { static function make() { return this.new() } }; class Child extends Base { function kind() { return "child" } }; Child.make().kind()`, "child"},
+		{`class Base { static function describe() { return "base" } }; class Child extends Base { static function describe() { return "child of " + super.describe() } }; Child.describe()`, "child of base"},
+		{`class Counter { static count = 0; function constructor() { Counter.count = Counter.count + 1 } }; Counter.new(); Counter.new(); Counter.count`, 2},
+		{`class Config { }; Config.missing`, "1:25:test.ghost: runtime error: unknown property: Config.missing"},
+		{`class Config { }; Config.missing()`, "1:25:test.ghost: runtime error: undefined method missing for class Config"},
+		{`static x = 1`, "1:1:test.ghost: runtime error: static members can only be declared in a class body"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			isNumberObject(t, result, int64(expected))
+		case string:
+			if isError(result) {
+				isErrorObject(t, result, expected)
+			} else {
+				isStringObject(t, result, expected)
+			}
+		default:
+			isNullObject(t, result)
+		}
+	}
+}
+
+func TestAccessors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`class Square { function constructor(size) { this.size = size }; get area() { return this.size * this.size } }; Square.new(3).area`, 9},
+		{`class Square { function constructor() { this.size = 1 }; set side(value) { this.size = value * 2 } }; s = Square.new(); s.side = 4; s.size`, 8},
+		{`class Temperature { function constructor() { this.#celsius = 0 }; get celsius() { return this.#celsius }; set celsius(value) { if (value < -273) { throw "too cold" }; this.#celsius = value } }; t = Temperature.new(); t.celsius = 21; t.celsius`, 21},
+		{`class Base { get kind() { return "base" } }; class Child extends Base { }; Child.new().kind`, "base"},
+		{`class Base { function constructor() { this.#secret = 42 } }; class Child extends Base { function reveal() { return this.#secret } }; Child.new().reveal()`, "1:120:test.ghost: runtime error: undefined property #secret for class Child"},
+		{`class Base { function constructor() { this.#x = 1 }; function base() { return this.#x } }; class Child extends Base { function constructor() { super.constructor(); this.#x = 2 }; function child() { return this.#x } }; c = Child.new(); c.base() * 10 + c.child()`, 12},
+		{`class Base { function constructor() { this.#x = 1 } }; class Other { function peek(base) { return base.#x } }; Other.new().peek(Base.new())`, "1:103:test.ghost: runtime error: cannot access private property #x outside of class Base"},
+		{`class Square { get area() { return 1 } }; s = Square.new(); s.area = 2`, "1:62:test.ghost: runtime error: cannot assign property area for class Square, it only has a getter"},
+		{`class Account { function constructor() { this.#balance = 10 } }; Account.new().#balance`, "1:79:test.ghost: runtime error: cannot access private property #balance outside of class Account"},
+		{`class Account { }; a = Account.new(); a.#balance = 10`, "1:40:test.ghost: runtime error: cannot access private property #balance outside of class Account"},
+		{`class Point { }; Point.new().x`, "1:29:test.ghost: runtime error: undefined property x for class Point"},
+		{`get x() { }`, "1:1:test.ghost: runtime error: getters and setters can only be declared in a class body"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			isNumberObject(t, result, int64(expected))
+		case string:
+			if isError(result) {
+				isErrorObject(t, result, expected)
+			} else {
+				isStringObject(t, result, expected)
+			}
+		}
+	}
+}
+
+func TestOperatorOverloading(t *testing.T) {
+	money := `class Money {
+	function constructor(cents) { this.cents = cents }
+	function add(other) { return Money.new(this.cents + other.cents) }
+	function subtract(other) { return Money.new(this.cents - other.cents) }
+	function multiply(factor) { return Money.new(this.cents * factor) }
+	function equals(other) { return this.cents == other.cents }
+	function compare(other) { return this.cents - other.cents }
+	function toString() { return "$${this.cents / 100}" }
+}
+`
+
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{money + `total = Money.new(150) + Money.new(250); total.cents`, 400},
+		{money + `total = Money.new(250) - Money.new(100); total.cents`, 150},
+		{money + `total = Money.new(150) * 3; total.cents`, 450},
+		{money + `Money.new(150) == Money.new(150)`, true},
+		{money + `Money.new(150) != Money.new(150)`, false},
+		{money + `Money.new(100) < Money.new(150)`, true},
+		{money + `Money.new(100) >= Money.new(150)`, false},
+		{money + `Money.new(150) <= Money.new(150)`, true},
+		{money + `"${Money.new(250)}"`, "$2.5"},
+		{money + `[Money.new(100), Money.new(200)].toString()`, "[$1, $2]"},
+		{`class Grid { function constructor() { this.cells = {} }; function index(key) { return this.cells[key] }; function setIndex(key, value) { this.cells[key] = value } }; grid = Grid.new(); grid["a1"] = 5; grid["a1"]`, 5},
+		{`class Box { }; Box.new() + 1`, "1:26:test.ghost: runtime error: unknown operator: Box + NUMBER, class Box does not define add()"},
+		{`class Box { function equals(other) { return 1 } }; Box.new() == Box.new()`, "1:62:test.ghost: runtime error: equals() of class Box must return a boolean, got NUMBER"},
+		{`class Box { }; Box.new()[0]`, "1:25:test.ghost: runtime error: index operator not supported: class Box does not define index()"},
+		{`class Box { }; box = Box.new(); box[0] = 1`, "1:36:test.ghost: runtime error: index assignment not supported: class Box does not define setIndex()"},
+		{`class Box { }; "${Box.new()}"`, "class instance Box"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			isNumberObject(t, result, int64(expected))
+		case bool:
+			isBooleanObject(t, result, expected)
+		case string:
+			if isError(result) {
+				isErrorObject(t, result, expected)
+			} else {
+				isStringObject(t, result, expected)
+			}
+		}
+	}
+}
+
+func TestTraits(t *testing.T) {
+	shape := `trait Shape {
+	abstract function area()
+	function describe() { return "area ${this.area()}" }
+}
+trait Named {
+	function describe() { return "named" }
+}
+`
+
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{shape + `class Square { use Shape; function area() { return 4 } }; Square.new().describe()`, "area 4"},
+		{shape + `class Base { function area() { return 9 } }; class Square extends Base { use Shape }; Square.new().describe()`, "area 9"},
+		{shape + `class Square { use Shape; use Named; function area() { return 4 }; function describe() { return Named.describe() + " " + Shape.describe() } }; Square.new().describe()`, "named area 4"},
+		{shape + `class Square { use Shape, Named; function area() { return 4 }; function describe() { return Shape.describe() } }; Square.new().describe()`, "area 4"},
+		{shape + `class Square { use Shape; function area() { return 4 } }; Square.new().implements(Shape)`, true},
+		{shape + `class Square { use Shape; function area() { return 4 } }; Square.new().implements(Named)`, false},
+		{shape + `class Square { use Shape; function area() { return 4 } }; class Cube extends Square { }; Cube.implements(Shape)`, true},
+		{shape + `class Square { use Shape }`, "8:7:test.ghost: runtime error: class Square must implement method area of trait Shape"},
+		{`trait Scalable { abstract function scale(factor) }; class Square { use Scalable; function scale() { } }`, "1:59:test.ghost: runtime error: method scale of class Square must accept the parameters (factor) of trait Scalable"},
+		{shape + `class Square { use Shape; use Named; function area() { return 4 } }`, "8:7:test.ghost: runtime error: method describe is defined by both traits Shape and Named, class Square must define it to resolve the conflict"},
+		{shape + `Named.describe()`, "8:6:test.ghost: runtime error: methods of trait Named can only be called within a class using it"},
+		{`abstract function area()`, "1:1:test.ghost: runtime error: abstract methods can only be declared in a trait body"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case bool:
+			isBooleanObject(t, result, expected)
+		case string:
+			if isError(result) {
+				isErrorObject(t, result, expected)
+			} else {
+				isStringObject(t, result, expected)
+			}
+		}
+	}
+}
+
+func TestReflectModule(t *testing.T) {
+	classes := `trait Named { function name() { return "named" } }
+class Animal {
+	use Named
+	function constructor(legs) { this.legs = legs; this.#id = 1 }
+	function speak(volume, ...words) { }
+}
+class Dog extends Animal {
+	static count = 0
+	function fetch() { return "fetching" }
+	function add(a, b) { return a + b }
+}
+dog = Dog.new(4)
+`
+
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{classes + `reflect.name(reflect.classOf(dog))`, "Dog"},
+		{classes + `reflect.classOf(1)`, nil},
+		{classes + `reflect.isInstanceOf(dog, Animal)`, true},
+		{classes + `reflect.isInstanceOf(Animal.new(2), Dog)`, false},
+		{classes + `reflect.isInstanceOf("dog", Dog)`, false},
+		{classes + `reflect.methods(dog).join(",")`, "add,constructor,fetch,name,speak"},
+		{classes + `reflect.properties(dog).join(",")`, "legs"},
+		{classes + `reflect.properties(Dog).join(",")`, "count"},
+		{classes + `reflect.name(reflect.traits(dog)[0])`, "Named"},
+		{classes + `reflect.hasMethod(dog, "fetch")`, true},
+		{classes + `reflect.hasMethod(Dog, "name")`, true},
+		{classes + `reflect.hasMethod(dog, "fly")`, false},
+		{classes + `reflect.call(dog, "fetch")`, "fetching"},
+		{classes + `reflect.call(dog, "add", [2, 3])`, 5},
+		{classes + `reflect.call(dog, "speak", [1])`, nil},
+		{classes + `reflect.arity(dog.speak)`, 1},
+		{classes + `reflect.parameters(dog.speak).join(",")`, "volume,...words"},
+		{classes + `reflect.call(dog, "fly")`, "13:8:test.ghost: runtime error: reflect.call() undefined method fly for class Dog"},
+		{classes + `reflect.arity(1)`, "13:8:test.ghost: runtime error: reflect.arity() expects the first argument to be of type 'function'. got=number"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			isNumberObject(t, result, int64(expected))
+		case bool:
+			isBooleanObject(t, result, expected)
+		case string:
+			if isError(result) {
+				isErrorObject(t, result, expected)
+			} else {
+				isStringObject(t, result, expected)
+			}
+		default:
+			isNullObject(t, result)
+		}
+	}
+}
+
+func TestDecorators(t *testing.T) {
+	decorators := `function twice(fn) { return (x) => fn(fn(x)) }
+function inc(fn) { return (x) => fn(x) + 1 }
+registered = []
+function register(name) { return (target) => { registered.push(name) } }
+function fail(target) { throw "decorator failed" }
+`
+
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{decorators + `@twice function double(x) { return x * 2 }; double(3)`, 12},
+		{decorators + `@inc @twice function double(x) { return x * 2 }; double(3)`, 13},
+		{decorators + `@twice @inc function double(x) { return x * 2 }; double(3)`, 15},
+		{decorators + `@register("double") function double(x) { return x * 2 }; double(3)`, 6},
+		{decorators + `@register("a") @register("b") function f() { }; registered.join(",")`, "b,a"},
+		{decorators + `@register("User") class User { }; registered[0]`, "User"},
+		{decorators + `function named(target) { return "decorated" }; @named class User { }; User`, "decorated"},
+		{decorators + `class Api { @register("list") function list() { return 1 } }; Api.new().list() + registered.length()`, 2},
+		{decorators + `@fail function f() { }`, "5:25:test.ghost: runtime error: decorator failed"},
+		{decorators + `@missing function f() { }`, "6:2:test.ghost: runtime error: unknown identifier: missing"},
+	}
+
+	for _, tt := range tests {
+		result := evaluate(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			isNumberObject(t, result, int64(expected))
+		case string:
+			if isError(result) {
+				isErrorObject(t, result, expected)
+			} else {
+				isStringObject(t, result, expected)
+			}
+		}
+	}
+}
+
+func TestErrorStackTraces(t *testing.T) {
+	input := `function inner() {
+	return 1 + missing
+}
+class Service {
+	function run() { return inner() }
+	static function start() { return Service.new().run() }
+}
+handler = () => Service.start()
+handler()`
+
+	result := evaluate(input)
+
+	err, ok := result.(*object.Error)
+
+	if !ok {
+		t.Fatalf("object is not Error. got=%T (%+v)", result, result)
+	}
+
+	expected := []object.Frame{
+		{Function: "inner", File: "test.ghost", Line: 5, Column: 31},
+		{Function: "Service.run", File: "test.ghost", Line: 6, Column: 48},
+		{Function: "Service.start", File: "test.ghost", Line: 8, Column: 24},
+		{Function: "anonymous function", File: "test.ghost", Line: 9, Column: 8},
+	}
+
+	if len(err.Stack) != len(expected) {
+		t.Fatalf("err.Stack does not contain %d frames. got=%d (%+v)", len(expected), len(err.Stack), err.Stack)
+	}
+
+	for index, frame := range expected {
+		if err.Stack[index] != frame {
+			t.Errorf("frame %d is wrong. expected=%+v, got=%+v", index, frame, err.Stack[index])
+		}
+	}
+
+	trace := "2:13:test.ghost: runtime error: unknown identifier: missing\n    in inner, called from test.ghost:5:31"
+
+	if !strings.HasPrefix(err.Trace(), trace) {
+		t.Errorf("err.Trace() is wrong. got=%q", err.Trace())
+	}
+}
+
+func TestStructuredErrors(t *testing.T) {
+	input := `try {
+	try { 5 + true } catch (inner) {
+		throw {message: "wrapped", kind: "ServiceError", cause: inner, data: {code: 42}}
+	}
+} catch (err) {
+	[err.kind, err.message, err.line, err.column, err.cause.kind, err.cause.line, err.cause.column, err.data.code]
+}`
+
+	result := evaluate(input)
+
+	list, ok := result.(*object.List)
+
+	if !ok {
+		t.Fatalf("object is not List. got=%T (%+v)", result, result)
+	}
+
+	isStringObject(t, list.Elements[0], "ServiceError")
+	isStringObject(t, list.Elements[1], "wrapped")
+	isNumberObject(t, list.Elements[2], 3)
+	isNumberObject(t, list.Elements[3], 3)
+	isStringObject(t, list.Elements[4], "TypeError")
+	isNumberObject(t, list.Elements[5], 2)
+	isNumberObject(t, list.Elements[6], 10)
+	isNumberObject(t, list.Elements[7], 42)
+
+	isNullObject(t, evaluate(`try { throw "oops" } catch (err) { err.cause }`))
+	isErrorObject(t, evaluate(`throw {kind: "ServiceError"}`), "1:1:test.ghost: runtime error: thrown map must have a string message")
+
+	result = evaluate("x = 1\nx + true")
+
+	err, ok := result.(*object.Error)
+
+	if !ok {
+		t.Fatalf("object is not Error. got=%T (%+v)", result, result)
+	}
+
+	if err.Kind != object.TypeError {
+		t.Errorf("err.Kind is wrong. expected=%q, got=%q", object.TypeError, err.Kind)
+	}
+
+	if err.Reason != "type mismatch: NUMBER + BOOLEAN" {
+		t.Errorf("err.Reason is wrong. got=%q", err.Reason)
+	}
+
+	if err.File != "test.ghost" || err.Line != 2 || err.Column != 3 {
+		t.Errorf("err position is wrong. got=%s:%d:%d", err.File, err.Line, err.Column)
+	}
+
+	result = evaluate(`ghost.abort("stop")`)
+
+	err, ok = result.(*object.Error)
+
+	if !ok {
+		t.Fatalf("object is not Error. got=%T (%+v)", result, result)
+	}
+
+	if err.Message != "stop" || err.Line != 1 || err.Column != 6 {
+		t.Errorf("abort error is wrong. got=%q at %d:%d", err.Message, err.Line, err.Column)
+	}
+}
+
 // =============================================================================
 // Helper functions
 
@@ -277,6 +1350,38 @@ func isNumberObject(t *testing.T, obj object.Object, expected int64) bool {
 	return true
 }
 
+func isBooleanObject(t *testing.T, obj object.Object, expected bool) bool {
+	boolean, ok := obj.(*object.Boolean)
+
+	if !ok {
+		t.Errorf("object is not Boolean. got=%T (%+v", obj, obj)
+		return false
+	}
+
+	if boolean.Value != expected {
+		t.Errorf("object has wrong value. got=%t, expected=%t", boolean.Value, expected)
+		return false
+	}
+
+	return true
+}
+
+func isStringObject(t *testing.T, obj object.Object, expected string) bool {
+	str, ok := obj.(*object.String)
+
+	if !ok {
+		t.Errorf("object is not String. got=%T (%+v", obj, obj)
+		return false
+	}
+
+	if str.Value != expected {
+		t.Errorf("object has wrong value. got=%q, expected=%q", str.Value, expected)
+		return false
+	}
+
+	return true
+}
+
 func isNil(t *testing.T, obj object.Object) bool {
 	if obj != nil {
 		t.Errorf("object is not nil. got=%T (%+v", obj, obj)
@@ -286,6 +1391,15 @@ func isNil(t *testing.T, obj object.Object) bool {
 	return true
 }
 
+func isNullObject(t *testing.T, obj object.Object) bool {
+	if _, ok := obj.(*object.Null); !ok {
+		t.Errorf("object is not Null. got=%T (%+v)", obj, obj)
+		return false
+	}
+
+	return true
+}
+
 func isClassObject(t *testing.T, obj object.Object, expected string) bool {
 	class, ok := obj.(*object.Class)
 
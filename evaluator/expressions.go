@@ -9,6 +9,18 @@ func evaluateExpressions(expressions []ast.ExpressionNode, scope *object.Scope)
 	var result []object.Object
 
 	for _, expression := range expressions {
+		if spread, ok := expression.(*ast.Spread); ok {
+			list := evaluateSpreadList(spread, scope)
+
+			if isError(list) {
+				return []object.Object{list}
+			}
+
+			result = append(result, list.(*object.List).Elements...)
+
+			continue
+		}
+
 		evaluated := Evaluate(expression, scope)
 
 		if isError(evaluated) {
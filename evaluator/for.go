@@ -7,33 +7,47 @@ import (
 )
 
 func evaluateFor(node *ast.For, scope *object.Scope) object.Object {
-	existingIdentifier, identifierExisted := scope.Environment.Get(node.Identifier.Value)
+	// The loop variable lives in its own block scope, so it neither overwrites
+	// nor leaks into the enclosing scope.
+	loopScope := newBlockScope(scope)
+	loopScope.Environment.Bind(node.Identifier.Value, value.NULL)
 
-	defer func() {
-		if identifierExisted {
-			scope.Environment.Set(node.Identifier.Value, existingIdentifier)
-		} else {
-			scope.Environment.Delete(node.Identifier.Value)
-		}
-	}()
-
-	initializer := Evaluate(node.Initializer, scope)
+	initializer := Evaluate(node.Initializer, loopScope)
 
 	if isError(initializer) {
 		return initializer
 	}
 
+	// The block is evaluated within the scope of each iteration, unless it
+	// declares a variable of its own shadowing the loop variable, which must
+	// not replace the value carried over to the next iteration.
+	shadowed := declares(node.Block, node.Identifier.Value)
 	loop := true
 
 	for loop {
-		condition := Evaluate(node.Condition, scope)
+		condition := Evaluate(node.Condition, loopScope)
 
 		if isError(condition) {
 			return condition
 		}
 
 		if isTruthy(condition) {
-			err := Evaluate(node.Block, scope)
+			// Each iteration receives its own copy of the loop variable, so
+			// closures created within the block capture that iteration's value.
+			iterationScope := newBlockScope(loopScope)
+			current, _ := loopScope.Environment.Get(node.Identifier.Value)
+			iterationScope.Environment.Bind(node.Identifier.Value, current)
+
+			var err object.Object
+
+			if shadowed {
+				err = Evaluate(node.Block, iterationScope)
+			} else {
+				err = evaluateStatements(node.Block, iterationScope)
+			}
+
+			updated, _ := iterationScope.Environment.Get(node.Identifier.Value)
+			loopScope.Environment.Set(node.Identifier.Value, updated)
 
 			if isTerminator(err) {
 				switch val := err.(type) {
@@ -43,10 +57,12 @@ func evaluateFor(node *ast.For, scope *object.Scope) object.Object {
 					//
 				case *object.Break:
 					return nil
+				case *object.Return:
+					return val
 				}
 			}
 
-			err = Evaluate(node.Increment, scope)
+			err = Evaluate(node.Increment, loopScope)
 
 			if isError(err) {
 				return err
@@ -60,3 +76,23 @@ func evaluateFor(node *ast.For, scope *object.Scope) object.Object {
 
 	return value.NULL
 }
+
+// declares reports whether a block declares name with let or const, outside
+// of any nested blocks.
+func declares(block *ast.Block, name string) bool {
+	for _, statement := range block.Statements {
+		declaration, ok := statement.(*ast.Declaration)
+
+		if !ok {
+			continue
+		}
+
+		for _, identifier := range patternIdentifiers(declaration.Name) {
+			if identifier.Value == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
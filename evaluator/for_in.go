@@ -13,30 +13,10 @@ func evaluateForIn(node *ast.ForIn, scope *object.Scope) object.Object {
 		return iterable
 	}
 
-	existingKey, keyExisted := scope.Environment.Get(node.Key.Value)
-	existingValue, valueExisted := scope.Environment.Get(node.Value.Value)
-
-	defer func() {
-		if keyExisted {
-			scope.Environment.Set(node.Key.Value, existingKey)
-		} else {
-			scope.Environment.Delete(node.Key.Value)
-		}
-
-		if valueExisted {
-			scope.Environment.Set(node.Value.Value, existingValue)
-		} else {
-			scope.Environment.Delete(node.Value.Value)
-		}
-	}()
-
 	switch obj := iterable.(type) {
 	case *object.List:
 		for k, v := range obj.Elements {
-			scope.Environment.Set(node.Key.Value, &object.Number{Value: decimal.NewFromInt(int64(k))})
-			scope.Environment.Set(node.Value.Value, v)
-
-			block := Evaluate(node.Block, scope)
+			block := evaluateForInBlock(node, &object.Number{Value: decimal.NewFromInt(int64(k))}, v, scope)
 
 			if isTerminator(block) {
 				switch val := block.(type) {
@@ -46,6 +26,8 @@ func evaluateForIn(node *ast.ForIn, scope *object.Scope) object.Object {
 					//
 				case *object.Break:
 					return nil
+				case *object.Return:
+					return val
 				}
 			}
 		}
@@ -53,10 +35,7 @@ func evaluateForIn(node *ast.ForIn, scope *object.Scope) object.Object {
 		return nil
 	case *object.Map:
 		for _, pair := range obj.Pairs {
-			scope.Environment.Set(node.Key.Value, pair.Key)
-			scope.Environment.Set(node.Value.Value, pair.Value)
-
-			block := Evaluate(node.Block, scope)
+			block := evaluateForInBlock(node, pair.Key, pair.Value, scope)
 
 			if isTerminator(block) {
 				switch val := block.(type) {
@@ -66,12 +45,91 @@ func evaluateForIn(node *ast.ForIn, scope *object.Scope) object.Object {
 					//
 				case *object.Break:
 					return nil
+				case *object.Return:
+					return val
 				}
 			}
 		}
 
 		return nil
+	case object.Iterable:
+		return evaluateForInIterator(node, obj.Iterator(), scope)
+	case *object.Instance:
+		iterator, err := instanceIterator(node.Token, obj)
+
+		if err != nil {
+			return err
+		}
+
+		return evaluateForInIterator(node, iterator, scope)
+	}
+
+	return newError(object.TypeError, node.Token, "unusable as for loop: %T", iterable)
+}
+
+// evaluateForInIterator consumes the iterator lazily, binding the position of
+// each element as its key. The iterator is closed if the loop exits early.
+func evaluateForInIterator(node *ast.ForIn, iterator object.Iterator, scope *object.Scope) object.Object {
+	for k := int64(0); ; k++ {
+		element, done := iterator.Next()
+
+		if err, ok := element.(*object.Error); ok {
+			err.Locate(node.Token)
+
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		block := evaluateForInBlock(node, &object.Number{Value: decimal.NewFromInt(k)}, element, scope)
+
+		if isTerminator(block) {
+			switch val := block.(type) {
+			case *object.Error:
+				iterator.Close()
+
+				return val
+			case *object.Continue:
+				//
+			case *object.Break:
+				iterator.Close()
+
+				return nil
+			case *object.Return:
+				iterator.Close()
+
+				return val
+			}
+		}
+	}
+}
+
+// evaluateForInBlock evaluates the loop block within a fresh block scope
+// binding the key and value, so closures created within the block capture
+// that iteration's key and value. Values destructured by a pattern are bound
+// in the same scope.
+func evaluateForInBlock(node *ast.ForIn, key object.Object, value object.Object, scope *object.Scope) object.Object {
+	iterationScope := newBlockScope(scope)
+
+	if node.Key.Value != "" {
+		iterationScope.Environment.Bind(node.Key.Value, key)
+	}
+
+	if identifier, ok := node.Value.(*ast.Identifier); ok {
+		iterationScope.Environment.Bind(identifier.Value, value)
+	} else {
+		for _, identifier := range patternIdentifiers(node.Value) {
+			iterationScope.Environment.Bind(identifier.Value, nil)
+		}
+
+		result := evaluateAssignment(node.Token, node.Value, value, iterationScope)
+
+		if isError(result) {
+			return result
+		}
 	}
 
-	return newError("%d:%d:%s: runtime error: unusable as for loop: %T", node.Token.Line, node.Token.Column, node.Token.File, iterable)
+	return evaluateStatements(node.Block, iterationScope)
 }
@@ -6,11 +6,14 @@ import (
 )
 
 func evaluateFunction(node *ast.Function, scope *object.Scope) object.Object {
-	function := &object.Function{
-		Parameters: node.Parameters,
-		Defaults:   node.Defaults,
-		Body:       node.Body,
-		Scope:      scope,
+	var function object.Object = newFunction(node, scope)
+
+	if len(node.Decorators) > 0 {
+		function = applyDecorators(node.Decorators, function, scope)
+
+		if isError(function) {
+			return function
+		}
 	}
 
 	if node.Name != nil {
@@ -25,6 +28,27 @@ func evaluateFunction(node *ast.Function, scope *object.Scope) object.Object {
 	return function
 }
 
+// newFunction creates a function object capturing the referenced scope,
+// without binding it to its name.
+func newFunction(node *ast.Function, scope *object.Scope) *object.Function {
+	name := ""
+
+	if node.Name != nil {
+		name = node.Name.Value
+	}
+
+	return &object.Function{
+		Name:       name,
+		Parameters: node.Parameters,
+		Defaults:   node.Defaults,
+		Rest:       node.Rest,
+		Body:       node.Body,
+		Scope:      scope,
+		Arrow:      node.Arrow,
+		Generator:  node.Generator,
+	}
+}
+
 func createFunctionEnvironment(function *object.Function, arguments []object.Object) *object.Environment {
 	env := object.NewEnclosedEnvironment(function.Scope.Environment)
 
@@ -33,10 +57,14 @@ func createFunctionEnvironment(function *object.Function, arguments []object.Obj
 	}
 
 	for index, parameter := range function.Parameters {
-		if index < len(arguments) {
+		if index < len(arguments) && arguments[index] != nil {
 			env.Set(parameter.Value, arguments[index])
 		}
 	}
 
+	if function.Rest != nil {
+		env.Set(function.Rest.Value, function.RestArguments(arguments))
+	}
+
 	return env
 }
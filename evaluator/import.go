@@ -28,7 +28,7 @@ func evaluateImport(node *ast.Import, scope *object.Scope) object.Object {
 	filename := findFile(node.Path.Value)
 
 	if filename == "" {
-		return object.NewError("%d:%d:%s: runtime error: no file found at '%s.ghost'", node.Token.Line, node.Token.Column, node.Token.File, node.Path.Value)
+		return newError(object.ImportError, node.Token, "no file found at '%s.ghost'", node.Path.Value)
 	}
 
 	// Have we imported this file before? If so, we don't need to do anything
@@ -55,7 +55,7 @@ func evaluateImportFrom(node *ast.ImportFrom, scope *object.Scope) object.Object
 	filename := findFile(node.Path.Value)
 
 	if filename == "" {
-		return object.NewError("%d:%d:%s: runtime error: no file found at '%s.ghost'", node.Token.Line, node.Token.Column, node.Token.File, node.Path.Value)
+		return newError(object.ImportError, node.Token, "no file found at '%s.ghost'", node.Path.Value)
 	}
 
 	// Have we imported this file before? If so, we don't need to do anything
@@ -70,7 +70,7 @@ func evaluateImportFrom(node *ast.ImportFrom, scope *object.Scope) object.Object
 			value, ok := moduleScope.Environment.Get(identifier.Value)
 
 			if !ok {
-				return object.NewError("%d:%d:%s: runtime error: identifier '%s' not found in module '%s.ghost'", node.Token.Line, node.Token.Column, node.Token.File, identifier.Value, node.Path.Value)
+				return newError(object.ImportError, node.Token, "identifier '%s' not found in module '%s.ghost'", identifier.Value, node.Path.Value)
 			}
 
 			scope.Environment.Set(alias, value)
@@ -95,7 +95,7 @@ func evaluateImportFrom(node *ast.ImportFrom, scope *object.Scope) object.Object
 		value, ok := moduleScope.(*object.Scope).Environment.Get(identifier.Value)
 
 		if !ok {
-			return object.NewError("%d:%d:%s: runtime error: identifier '%s' not found in module '%s.ghost'", node.Token.Line, node.Token.Column, node.Token.File, identifier.Value, node.Path.Value)
+			return newError(object.ImportError, node.Token, "identifier '%s' not found in module '%s.ghost'", identifier.Value, node.Path.Value)
 		}
 
 		scope.Environment.Set(alias, value)
@@ -118,7 +118,7 @@ func evaluateFile(file string, tok token.Token, scope *object.Scope) object.Obje
 	source, err := ioutil.ReadFile(file)
 
 	if err != nil {
-		return object.NewError("%d:%d:%s: runtime error: %s", tok.Line, tok.Column, tok.File, err)
+		return newError(object.RuntimeError, tok, "%s", err)
 	}
 
 	directory := scope.Environment.GetDirectory()
@@ -128,12 +128,12 @@ func evaluateFile(file string, tok token.Token, scope *object.Scope) object.Obje
 	parser := parser.New(scanner)
 	program := parser.Parse()
 
-	if len(parser.Errors()) != 0 {
-		for _, message := range parser.Errors() {
-			log.Error(message)
+	if diagnostics := parser.Diagnostics(); len(diagnostics) != 0 {
+		for _, diagnostic := range diagnostics {
+			log.Error("%s\n%s", diagnostic.String(), diagnostic.Snippet())
 		}
 
-		return nil
+		return traceError(SyntaxError(diagnostics), "import of "+currentFile, tok)
 	}
 
 	newScope := &object.Scope{Self: scope.Self, Environment: object.NewEnvironment()}
@@ -142,7 +142,7 @@ func evaluateFile(file string, tok token.Token, scope *object.Scope) object.Obje
 	result := Evaluate(program, newScope)
 
 	if isError(result) {
-		return result
+		return traceError(result, "import of "+currentFile, tok)
 	}
 
 	return newScope
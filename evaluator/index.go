@@ -7,27 +7,40 @@ import (
 )
 
 func evaluateIndex(node *ast.Index, scope *object.Scope) object.Object {
-	left := Evaluate(node.Left, scope)
+	left := evaluateChainLink(node.Left, scope)
 
-	if isError(left) {
+	if isError(left) || left == shortCircuit {
 		return left
 	}
 
+	if node.Optional && left.Type() == object.NULL {
+		return shortCircuit
+	}
+
 	index := Evaluate(node.Index, scope)
 
 	if isError(index) {
 		return index
 	}
 
+	return evaluateIndexOf(node, left, index)
+}
+
+// evaluateIndexOf reads the index of an evaluated value.
+func evaluateIndexOf(node *ast.Index, left object.Object, index object.Object) object.Object {
 	switch {
 	case left.Type() == object.STRING && index.Type() == object.NUMBER:
 		return evaluateStringIndex(node, left, index)
 	case left.Type() == object.LIST && index.Type() == object.NUMBER:
 		return evaluateListIndex(node, left, index)
+	case left.Type() == object.RANGE && index.Type() == object.NUMBER:
+		return evaluateRangeIndex(node, left, index)
 	case left.Type() == object.MAP:
 		return evaluateMapIndex(node, left, index)
+	case left.Type() == object.INSTANCE:
+		return evaluateInstanceIndex(node, left.(*object.Instance), index)
 	default:
-		return newError("%d:%d:%s: runtime error: index operator not supported: %s", node.Token.Line, node.Token.Column, node.Token.File, left.Type())
+		return newError(object.TypeError, node.Token, "index operator not supported: %s", left.Type())
 	}
 }
 
@@ -43,13 +56,23 @@ func evaluateListIndex(node *ast.Index, left, index object.Object) object.Object
 	return list.Elements[idx]
 }
 
+func evaluateRangeIndex(node *ast.Index, left, index object.Object) object.Object {
+	element, ok := left.(*object.Range).At(index.(*object.Number).Value.IntPart())
+
+	if !ok {
+		return value.NULL
+	}
+
+	return element
+}
+
 func evaluateMapIndex(node *ast.Index, left, index object.Object) object.Object {
 	mapObject := left.(*object.Map)
 
 	key, ok := index.(object.Mappable)
 
 	if !ok {
-		return newError("%d:%d:%s: runtime error: unusable as map key: %s", node.Token.Line, node.Token.Column, node.Token.File, index.Type())
+		return newError(object.TypeError, node.Token, "unusable as map key: %s", index.Type())
 	}
 
 	pair, ok := mapObject.Pairs[key.MapKey()]
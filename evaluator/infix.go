@@ -12,12 +12,31 @@ func evaluateInfix(node *ast.Infix, scope *object.Scope) object.Object {
 		return left
 	}
 
+	// The right side of "??" is only evaluated when the left side is null
+	if node.Operator == "??" {
+		if left.Type() != object.NULL {
+			return left
+		}
+
+		return Evaluate(node.Right, scope)
+	}
+
 	right := Evaluate(node.Right, scope)
 
 	if isError(right) {
 		return right
 	}
 
+	return evaluateInfixOperands(node, left, right)
+}
+
+// evaluateInfixOperands applies the operator of an infix expression to its
+// evaluated operands.
+func evaluateInfixOperands(node *ast.Infix, left object.Object, right object.Object) object.Object {
+	if isOverloadable(left, node.Operator) {
+		return evaluateInstanceInfix(node, left.(*object.Instance), right)
+	}
+
 	switch {
 	case left.Type() == object.BOOLEAN && right.Type() == object.BOOLEAN:
 		return evaluateBooleanInfix(node, left, right)
@@ -25,9 +44,11 @@ func evaluateInfix(node *ast.Infix, scope *object.Scope) object.Object {
 		return evaluateNumberInfix(node, left, right)
 	case left.Type() == object.STRING && right.Type() == object.STRING:
 		return evaluateStringInfix(node, left, right)
+	case left.Type() == object.ENUM_MEMBER && right.Type() == object.ENUM_MEMBER:
+		return evaluateEnumMemberInfix(node, left, right)
 	case left.Type() != right.Type():
-		return newError("%d:%d:%s: runtime error: type mismatch: %s %s %s", node.Token.Line, node.Token.Column, node.Token.File, left.Type(), node.Operator, right.Type())
+		return newError(object.TypeError, node.Token, "type mismatch: %s %s %s", left.Type(), node.Operator, right.Type())
 	}
 
-	return newError("%d:%d:%s: runtime error: unknown operator: %s %s %s", node.Token.Line, node.Token.Column, node.Token.File, left.Type(), node.Operator, right.Type())
+	return newError(object.TypeError, node.Token, "unknown operator: %s %s %s", left.Type(), node.Operator, right.Type())
 }
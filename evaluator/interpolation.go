@@ -0,0 +1,29 @@
+package evaluator
+
+import (
+	"strings"
+
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/value"
+)
+
+func evaluateInterpolation(node *ast.Interpolation, scope *object.Scope) object.Object {
+	var out strings.Builder
+
+	for _, part := range node.Parts {
+		evaluated := Evaluate(part, scope)
+
+		if isError(evaluated) {
+			return evaluated
+		}
+
+		if evaluated == nil {
+			evaluated = value.NULL
+		}
+
+		out.WriteString(evaluated.String())
+	}
+
+	return &object.String{Value: out.String()}
+}
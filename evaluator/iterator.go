@@ -0,0 +1,91 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/token"
+	"ghostlang.org/x/ghost/value"
+)
+
+// protocolIterator iterates a class instance through its next() method, which
+// returns a map with "value" and "done" keys.
+type protocolIterator struct {
+	instance *object.Instance
+	tok      token.Token
+}
+
+// instanceIterator returns an iterator over the referenced instance. Instances
+// defining iterator() are iterated through the object it returns, either a
+// generator or another instance defining next(). Instances defining only
+// next() are iterated directly.
+func instanceIterator(tok token.Token, instance *object.Instance) (object.Iterator, object.Object) {
+	if method, _ := instance.Class.FindMethod("iterator"); method != nil {
+		result := unwrapReturn(instance.Call("iterator", []object.Object{}, tok))
+
+		switch result := result.(type) {
+		case *object.Error:
+			return nil, result
+		case object.Iterable:
+			return result.Iterator(), nil
+		case *object.Instance:
+			if method, _ := result.Class.FindMethod("next"); method != nil {
+				return &protocolIterator{instance: result, tok: tok}, nil
+			}
+		}
+
+		return nil, newError(object.TypeError, tok, "iterator() of class %s must return an iterator, got %s", instance.Class.Name.Value, result.Type())
+	}
+
+	if method, _ := instance.Class.FindMethod("next"); method != nil {
+		return &protocolIterator{instance: instance, tok: tok}, nil
+	}
+
+	return nil, newError(object.TypeError, tok, "unusable as for loop: class %s does not define iterator() or next()", instance.Class.Name.Value)
+}
+
+// iterableToList consumes the elements of the iterable into a list.
+func iterableToList(iterable object.Iterable) object.Object {
+	iterator := iterable.Iterator()
+	elements := []object.Object{}
+
+	for {
+		element, done := iterator.Next()
+
+		if isError(element) {
+			return element
+		}
+
+		if done {
+			return &object.List{Elements: elements}
+		}
+
+		elements = append(elements, element)
+	}
+}
+
+// Next calls the instance's next() method and unpacks the returned map.
+func (iterator *protocolIterator) Next() (object.Object, bool) {
+	result := unwrapReturn(iterator.instance.Call("next", []object.Object{}, iterator.tok))
+
+	if isError(result) {
+		return result, true
+	}
+
+	step, ok := result.(*object.Map)
+
+	if !ok {
+		return newError(object.TypeError, iterator.tok, "next() of class %s must return a map, got %s", iterator.instance.Class.Name.Value, result.Type()), true
+	}
+
+	if done, ok := step.Pairs[(&object.String{Value: "done"}).MapKey()]; ok && isTruthy(done.Value) {
+		return nil, true
+	}
+
+	if element, ok := step.Pairs[(&object.String{Value: "value"}).MapKey()]; ok {
+		return element.Value, false
+	}
+
+	return value.NULL, false
+}
+
+// Close does nothing, as instances hold no resources on behalf of the loop.
+func (iterator *protocolIterator) Close() {}
@@ -8,7 +8,23 @@ import (
 func evaluateMap(node *ast.Map, scope *object.Scope) object.Object {
 	pairs := make(map[object.MapKey]object.MapPair)
 
-	for keyNode, valueNode := range node.Pairs {
+	for _, keyNode := range node.Keys {
+		if spread, ok := keyNode.(*ast.Spread); ok {
+			spreadMap := evaluateSpreadMap(spread, scope)
+
+			if isError(spreadMap) {
+				return spreadMap
+			}
+
+			for hashed, pair := range spreadMap.(*object.Map).Pairs {
+				pairs[hashed] = pair
+			}
+
+			continue
+		}
+
+		valueNode := node.Pairs[keyNode]
+
 		// if keyNode is an identifier, convert it to a string
 		identifier, ok := keyNode.(*ast.Identifier)
 
@@ -28,7 +44,7 @@ func evaluateMap(node *ast.Map, scope *object.Scope) object.Object {
 		mapKey, ok := key.(object.Mappable)
 
 		if !ok {
-			return newError("%d:%d:%s: runtime error: unusable as map key: %s", node.Token.Line, node.Token.Column, node.Token.File, key.Type())
+			return newError(object.TypeError, node.Token, "unusable as map key: %s", key.Type())
 		}
 
 		value := Evaluate(valueNode, scope)
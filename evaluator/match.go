@@ -0,0 +1,271 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/token"
+	"ghostlang.org/x/ghost/value"
+)
+
+// evaluateMatch evaluates the body of the first case with a pattern matching
+// the value, and whose guard holds. Identifiers bound by the pattern are local
+// to the case. The default case is used when no other case matches, and the
+// match evaluates to null if there is none.
+func evaluateMatch(node *ast.Match, scope *object.Scope) object.Object {
+	subject := Evaluate(node.Value, scope)
+
+	if isError(subject) {
+		return subject
+	}
+
+	if subject == nil {
+		subject = value.NULL
+	}
+
+	for _, matchCase := range node.Cases {
+		if matchCase.Default {
+			continue
+		}
+
+		for _, pattern := range matchCase.Patterns {
+			caseScope := newBlockScope(scope)
+			matched, err := matchPattern(pattern, subject, caseScope)
+
+			if err != nil {
+				return err
+			}
+
+			if !matched {
+				continue
+			}
+
+			if matchCase.Guard != nil {
+				guard := Evaluate(matchCase.Guard, caseScope)
+
+				if isError(guard) {
+					return guard
+				}
+
+				if !isTruthy(guard) {
+					continue
+				}
+			}
+
+			return evaluateMatchBody(matchCase.Body, caseScope)
+		}
+	}
+
+	for _, matchCase := range node.Cases {
+		if matchCase.Default {
+			return evaluateMatchBody(matchCase.Body, scope)
+		}
+	}
+
+	return value.NULL
+}
+
+func evaluateMatchBody(body *ast.Block, scope *object.Scope) object.Object {
+	result := evaluateBlock(body, scope)
+
+	if result == nil {
+		return value.NULL
+	}
+
+	return result
+}
+
+// matchPattern determines if the subject matches the pattern, declaring any
+// identifiers the pattern binds within the scope.
+func matchPattern(pattern ast.ExpressionNode, subject object.Object, scope *object.Scope) (bool, object.Object) {
+	switch pattern := pattern.(type) {
+	case *ast.Identifier:
+		// The "_" wildcard matches anything without binding it
+		if pattern.Value != "_" {
+			scope.Environment.Bind(pattern.Value, subject)
+		}
+
+		return true, nil
+	case *ast.Range:
+		evaluated := Evaluate(pattern, scope)
+
+		if isError(evaluated) {
+			return false, evaluated
+		}
+
+		number, ok := subject.(*object.Number)
+
+		return ok && evaluated.(*object.Range).Contains(number.Value), nil
+	case *ast.List:
+		return matchListPattern(pattern, subject, scope)
+	case *ast.Map:
+		return matchMapPattern(pattern, subject, scope)
+	case *ast.Call:
+		return matchClassPattern(pattern, subject, scope)
+	}
+
+	expected := Evaluate(pattern, scope)
+
+	if isError(expected) {
+		return false, expected
+	}
+
+	return subject.Type() == expected.Type() && subject.String() == expected.String(), nil
+}
+
+// matchListPattern matches a list whose elements match the pattern's elements
+// in order. A trailing ...rest element matches any remaining elements.
+func matchListPattern(pattern *ast.List, subject object.Object, scope *object.Scope) (bool, object.Object) {
+	list, ok := subject.(*object.List)
+
+	if !ok {
+		return false, nil
+	}
+
+	elements := pattern.Elements
+	var rest *ast.Identifier
+
+	if len(elements) > 0 {
+		if spread, ok := elements[len(elements)-1].(*ast.Spread); ok {
+			rest = spread.Value.(*ast.Identifier)
+			elements = elements[:len(elements)-1]
+		}
+	}
+
+	if len(list.Elements) < len(elements) || (rest == nil && len(list.Elements) != len(elements)) {
+		return false, nil
+	}
+
+	for index, element := range elements {
+		matched, err := matchPattern(element, list.Elements[index], scope)
+
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	if rest != nil && rest.Value != "_" {
+		remaining := append([]object.Object{}, list.Elements[len(elements):]...)
+
+		scope.Environment.Bind(rest.Value, &object.List{Elements: remaining})
+	}
+
+	return true, nil
+}
+
+// matchMapPattern matches a map containing each of the pattern's keys, with
+// values matching the key's pattern. Class instances are matched against
+// their properties.
+func matchMapPattern(pattern *ast.Map, subject object.Object, scope *object.Scope) (bool, object.Object) {
+	for _, keyNode := range pattern.Keys {
+		valuePattern := pattern.Pairs[keyNode]
+
+		var key object.Object
+
+		tok := pattern.Token
+
+		if identifier, ok := keyNode.(*ast.Identifier); ok {
+			key = &object.String{Value: identifier.Value}
+			tok = identifier.Token
+		} else {
+			key = Evaluate(keyNode, scope)
+
+			if isError(key) {
+				return false, key
+			}
+		}
+
+		element, ok := lookupPatternKey(tok, subject, key, scope)
+
+		if !ok {
+			return false, nil
+		}
+
+		if isError(element) {
+			return false, element
+		}
+
+		matched, err := matchPattern(valuePattern, element, scope)
+
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	switch subject.(type) {
+	case *object.Map, *object.Instance:
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// lookupPatternKey reads the value of a map pattern's key from the subject.
+// Instance properties are read as if accessed with a dot, so getters are
+// called and private properties are only accessible within the class.
+func lookupPatternKey(tok token.Token, subject object.Object, key object.Object, scope *object.Scope) (object.Object, bool) {
+	switch subject := subject.(type) {
+	case *object.Map:
+		mapKey, ok := key.(object.Mappable)
+
+		if !ok {
+			return nil, false
+		}
+
+		pair, ok := subject.Pairs[mapKey.MapKey()]
+
+		return pair.Value, ok
+	case *object.Instance:
+		name, ok := key.(*object.String)
+
+		if !ok {
+			return nil, false
+		}
+
+		node := &ast.Property{Token: tok, Property: &ast.Identifier{Token: tok, Value: name.Value}}
+
+		return lookupInstanceProperty(node, subject, scope)
+	}
+
+	return nil, false
+}
+
+// matchClassPattern matches an instance of the class named by the pattern, or
+// of one of its subclasses. The instance itself must match the pattern's
+// argument, if any.
+func matchClassPattern(pattern *ast.Call, subject object.Object, scope *object.Scope) (bool, object.Object) {
+	callee := Evaluate(pattern.Callee, scope)
+
+	if isError(callee) {
+		return false, callee
+	}
+
+	class, ok := callee.(*object.Class)
+
+	if !ok {
+		return false, newError(object.TypeError, pattern.Token, "match pattern expects a class, got %s", callee.Type())
+	}
+
+	instance, ok := subject.(*object.Instance)
+
+	if !ok || !isSubclass(instance.Class, class) {
+		return false, nil
+	}
+
+	if len(pattern.Arguments) == 0 {
+		return true, nil
+	}
+
+	return matchPattern(pattern.Arguments[0], instance, scope)
+}
+
+// isSubclass determines if the class is the referenced ancestor, or inherits
+// from it.
+func isSubclass(class *object.Class, ancestor *object.Class) bool {
+	for current := class; current != nil; current = current.Super {
+		if current == ancestor {
+			return true
+		}
+	}
+
+	return false
+}
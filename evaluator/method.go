@@ -6,97 +6,154 @@ import (
 )
 
 func evaluateMethod(node *ast.Method, scope *object.Scope) object.Object {
-	left := Evaluate(node.Left, scope)
+	if super, ok := node.Left.(*ast.Super); ok {
+		return evaluateSuperMethod(super, node, scope)
+	}
+
+	left := evaluateChainLink(node.Left, scope)
 
-	if isError(left) {
+	if isError(left) || left == shortCircuit {
 		return left
 	}
 
+	if node.Optional && left.Type() == object.NULL {
+		return shortCircuit
+	}
+
 	arguments := evaluateExpressions(node.Arguments, scope)
 
 	if len(arguments) == 1 && isError(arguments[0]) {
 		return arguments[0]
 	}
 
+	named, err := evaluateNamedArguments(node.Named, scope)
+
+	if err != nil {
+		return err
+	}
+
+	if named != nil {
+		switch receiver := left.(type) {
+		case *object.Class:
+			if node.Method.(*ast.Identifier).Value == "new" {
+				arguments, err = evaluateConstructorArguments(node, receiver, arguments, named)
+
+				if err != nil {
+					return err
+				}
+			}
+		case *object.Map, *object.Instance, *object.Trait, *object.LibraryModule:
+			// Named arguments are matched when the method is called
+		default:
+			return newError(object.ArgumentError, node.Token, "%s methods do not accept named arguments", left.Type())
+		}
+	}
+
 	result, _ := left.Method(node.Method.(*ast.Identifier).Value, arguments)
 
-	if isError(result) {
-		return result
+	if err, ok := result.(*object.Error); ok {
+		err.Locate(node.Token)
+
+		return err
 	}
 
 	switch receiver := left.(type) {
+	case *object.Class:
+		// Methods built into every class, such as new, take precedence
+		if result != nil {
+			return result
+		}
+
+		method := node.Method.(*ast.Identifier)
+
+		return evaluateStaticMethod(node, receiver, receiver, method.Value, arguments, named, scope)
 	case *object.Map:
 		method := node.Method.(*ast.Identifier)
 
 		property := &object.String{Value: method.Value}
 
 		if function, ok := receiver.Pairs[property.MapKey()]; ok {
-			return unwrapCall(node.Token, function.Value, arguments, scope)
+			return unwrapCall(node.Token, function.Value, arguments, named, scope)
 		}
 
-		return newError("%d:%d:%s: runtime error: unknown method: %s.%s", node.Token.Line, node.Token.Column, node.Token.File, receiver.Type(), method.Value)
+		return newError(object.NameError, node.Token, "unknown method: %s.%s", receiver.Type(), method.Value)
 	case *object.Instance:
 		method := node.Method.(*ast.Identifier)
-		evaluated := evaluateInstanceMethod(node, receiver, method.Value, arguments)
+
+		// Methods built into every instance, such as implements, only apply
+		// when the class does not define a method of the same name
+		if function, _ := receiver.Class.FindMethod(method.Value); function == nil && result != nil {
+			return result
+		}
+
+		evaluated := evaluateInstanceMethod(node, receiver, method.Value, arguments, named)
 
 		if isError(evaluated) {
 			return evaluated
 		}
 
 		return unwrapReturn(evaluated)
+	case *object.Trait:
+		method := node.Method.(*ast.Identifier)
+
+		return evaluateTraitMethod(node, receiver, method.Value, arguments, named, scope)
 	case *object.LibraryModule:
 		method := node.Method.(*ast.Identifier)
 		module := left.(*object.LibraryModule)
 
 		if function, ok := module.Methods[method.Value]; ok {
-			return unwrapCall(node.Token, function, arguments, scope)
+			return unwrapCall(node.Token, function, arguments, named, scope)
 		}
 	}
 
 	return result
 }
 
-func evaluateInstanceMethod(node *ast.Method, receiver *object.Instance, name string, arguments []object.Object) object.Object {
-	class := receiver.Class
-	method, ok := receiver.Class.Environment.Get(name)
-
-	// If we dont have a method, loop through the super classes and check them.
-	// Then check the traits.
-	if !ok {
-		for class != nil {
-			method, ok = class.Environment.Get(name)
-
-			if !ok {
-				class = class.Super
-			} else {
-				class = nil
-			}
-		}
-	}
-
-	// if we dont have a method, check for a trait
-	if method == nil {
-		for _, trait := range receiver.Class.Traits {
-			method, ok = trait.Environment.Get(name)
+func evaluateInstanceMethod(node *ast.Method, receiver *object.Instance, name string, arguments []object.Object, named *object.Map) object.Object {
+	return evaluateClassMethod(node, receiver, receiver.Class, name, arguments, named)
+}
 
-			if !ok {
-				return object.NewError("%d:%d:%s: runtime error: undefined method %s for class %s", node.Token.Line, node.Token.Column, node.Token.File, name, receiver.Class.Name.Value)
-			}
-		}
-	}
+// evaluateClassMethod looks up the named method starting at the referenced
+// class and evaluates it with the receiver bound to "this".
+func evaluateClassMethod(node *ast.Method, receiver *object.Instance, class *object.Class, name string, arguments []object.Object, named *object.Map) object.Object {
+	method, definedIn := class.FindMethod(name)
 
 	// if we still dont have a method, return an error
 	if method == nil {
-		return object.NewError("%d:%d:%s: runtime error: undefined method %s for class %s", node.Token.Line, node.Token.Column, node.Token.File, name, receiver.Class.Name.Value)
+		return newError(object.NameError, node.Token, "undefined method %s for class %s", name, receiver.Class.Name.Value)
 	}
 
 	switch method := method.(type) {
 	case *object.Function:
+		arguments, err := bindNamedArguments(node.Token, method, arguments, named)
+
+		if err != nil {
+			return err
+		}
+
 		env := createFunctionEnvironment(method, arguments)
-		scope := &object.Scope{Self: receiver, Environment: env}
+		scope := &object.Scope{Self: receiver, Environment: env, Class: definedIn}
+
+		if method.Generator {
+			return &object.Return{Value: object.NewGenerator(method.Body, scope)}
+		}
 
-		return Evaluate(method.Body, scope)
+		return traceError(Evaluate(method.Body, scope), definedIn.Name.Value+"."+name, node.Token)
 	default:
-		return object.NewError("%d:%d:%s: runtime error: invalid type %T in class %s", node.Token.Line, node.Token.Column, node.Token.File, method, receiver.Class.Name.Value)
+		return newError(object.TypeError, node.Token, "invalid type %T in class %s", method, receiver.Class.Name.Value)
 	}
 }
+
+// evaluateConstructorArguments matches the named arguments given to "new"
+// against the parameters of the class constructor.
+func evaluateConstructorArguments(node *ast.Method, class *object.Class, arguments []object.Object, named *object.Map) ([]object.Object, object.Object) {
+	constructor, _ := class.FindMethod("constructor")
+
+	function, ok := constructor.(*object.Function)
+
+	if !ok {
+		return nil, newError(object.ArgumentError, node.Token, "class %s does not have a constructor accepting named arguments", class.Name.Value)
+	}
+
+	return bindNamedArguments(node.Token, function, arguments, named)
+}
@@ -0,0 +1,69 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/token"
+)
+
+// evaluateNamedArguments evaluates the named arguments of a call into a map
+// keyed by argument name. A nil map is returned when there are none.
+func evaluateNamedArguments(arguments []*ast.NamedArgument, scope *object.Scope) (*object.Map, object.Object) {
+	if len(arguments) == 0 {
+		return nil, nil
+	}
+
+	pairs := make(map[object.MapKey]object.MapPair)
+
+	for _, argument := range arguments {
+		value := Evaluate(argument.Value, scope)
+
+		if isError(value) {
+			return nil, value
+		}
+
+		key := &object.String{Value: argument.Name.Value}
+		pairs[key.MapKey()] = object.MapPair{Key: key, Value: value}
+	}
+
+	return &object.Map{Pairs: pairs}, nil
+}
+
+// bindNamedArguments places each named argument at the position of the
+// function parameter with the same name. Parameters left without an argument
+// are nil, so their default values apply.
+func bindNamedArguments(tok token.Token, function *object.Function, arguments []object.Object, named *object.Map) ([]object.Object, object.Object) {
+	if named == nil {
+		return arguments, nil
+	}
+
+	bound := make([]object.Object, len(function.Parameters))
+	copy(bound, arguments)
+
+	if len(arguments) > len(bound) {
+		bound = append(bound, arguments[len(bound):]...)
+	}
+
+	for _, pair := range named.Pairs {
+		name := pair.Key.(*object.String).Value
+		position := -1
+
+		for index, parameter := range function.Parameters {
+			if parameter.Value == name {
+				position = index
+			}
+		}
+
+		if position == -1 {
+			return nil, newError(object.ArgumentError, tok, "unknown named argument: %s", name)
+		}
+
+		if position < len(arguments) {
+			return nil, newError(object.ArgumentError, tok, "multiple values for argument: %s", name)
+		}
+
+		bound[position] = pair.Value
+	}
+
+	return bound, nil
+}
@@ -1,6 +1,8 @@
 package evaluator
 
 import (
+	"math"
+
 	"ghostlang.org/x/ghost/ast"
 	"ghostlang.org/x/ghost/object"
 	"github.com/shopspring/decimal"
@@ -25,6 +27,16 @@ func evaluateNumberInfix(node *ast.Infix, left object.Object, right object.Objec
 		return &object.Number{Value: leftValue.Div(rightValue)}
 	case "%":
 		return &object.Number{Value: leftValue.Mod(rightValue)}
+	case "**":
+		return evaluateExponent(node, leftValue, rightValue)
+	case "~/":
+		if rightValue.IsZero() {
+			return newError(object.ArithmeticError, node.Token, "division by zero")
+		}
+
+		return &object.Number{Value: leftValue.Div(rightValue).Floor()}
+	case "&", "|", "^", "<<", ">>":
+		return evaluateBitwiseInfix(node, leftValue, rightValue)
 	case "<":
 		return toBooleanValue(leftValue.LessThan(rightValue))
 	case "<=":
@@ -37,27 +49,62 @@ func evaluateNumberInfix(node *ast.Infix, left object.Object, right object.Objec
 		return toBooleanValue(leftValue.Equal(rightValue))
 	case "!=":
 		return toBooleanValue(!leftValue.Equal(rightValue))
-	case "..":
-		numbers := make([]object.Object, 0)
-		one := decimal.NewFromInt(1)
-		number := leftValue
+	}
 
-		if leftValue.GreaterThan(rightValue) {
-			return &object.List{Elements: numbers}
-		}
+	return newError(object.TypeError, node.Token, "unknown operator: %s %s %s", right.Type(), node.Operator, left.Type())
+}
 
-		for {
-			numbers = append(numbers, &object.Number{Value: number})
+// evaluateExponent raises the left value to the power of the right value.
+// Integer exponents are calculated exactly, while fractional exponents fall
+// back to floating point precision.
+func evaluateExponent(node *ast.Infix, leftValue decimal.Decimal, rightValue decimal.Decimal) object.Object {
+	if leftValue.IsZero() && rightValue.IsNegative() {
+		return newError(object.ArithmeticError, node.Token, "division by zero")
+	}
 
-			if number.GreaterThanOrEqual(rightValue) {
-				break
-			}
+	if rightValue.IsInteger() {
+		return &object.Number{Value: leftValue.Pow(rightValue)}
+	}
 
-			number = number.Add(one)
-		}
+	result := math.Pow(leftValue.InexactFloat64(), rightValue.InexactFloat64())
+
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return newError(object.ArithmeticError, node.Token, "invalid exponent: %s ** %s", leftValue, rightValue)
+	}
+
+	return &object.Number{Value: decimal.NewFromFloat(result)}
+}
+
+// evaluateBitwiseInfix applies a bitwise operator to two integral numbers.
+func evaluateBitwiseInfix(node *ast.Infix, leftValue decimal.Decimal, rightValue decimal.Decimal) object.Object {
+	if !leftValue.IsInteger() || !rightValue.IsInteger() {
+		return newError(object.TypeError, node.Token, "bitwise operator %s requires integer operands", node.Operator)
+	}
+
+	left := leftValue.IntPart()
+	right := rightValue.IntPart()
+
+	switch node.Operator {
+	case "&":
+		return &object.Number{Value: decimal.NewFromInt(left & right)}
+	case "|":
+		return &object.Number{Value: decimal.NewFromInt(left | right)}
+	case "^":
+		return &object.Number{Value: decimal.NewFromInt(left ^ right)}
+	}
+
+	if right < 0 {
+		return newError(object.ArithmeticError, node.Token, "negative shift count: %d", right)
+	}
+
+	// Numbers are shifted as 64 bit integers, which larger counts would clear
+	if right >= 64 {
+		return newError(object.ArithmeticError, node.Token, "shift count too large: %d", right)
+	}
 
-		return &object.List{Elements: numbers}
+	if node.Operator == "<<" {
+		return &object.Number{Value: decimal.NewFromInt(left << right)}
 	}
 
-	return newError("%d:%d:%s: runtime error: unknown operator: %s %s %s", node.Token.Line, node.Token.Column, node.Token.File, right.Type(), node.Operator, left.Type())
+	return &object.Number{Value: decimal.NewFromInt(left >> right)}
 }
@@ -0,0 +1,116 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/token"
+)
+
+// operatorMethods maps the operators a class may overload to the name of the
+// instance method implementing them.
+var operatorMethods = map[string]string{
+	"+":  "add",
+	"-":  "subtract",
+	"*":  "multiply",
+	"/":  "divide",
+	"%":  "modulo",
+	"==": "equals",
+	"!=": "equals",
+	"<":  "compare",
+	"<=": "compare",
+	">":  "compare",
+	">=": "compare",
+}
+
+// isOverloadable reports whether the infix operator should be dispatched to a
+// method of the left operand.
+func isOverloadable(left object.Object, operator string) bool {
+	_, ok := operatorMethods[operator]
+
+	return ok && left.Type() == object.INSTANCE
+}
+
+// evaluateInstanceInfix evaluates an infix expression by calling the method
+// overloading the operator on the left operand. equals() must return a
+// boolean and compare() a number that is negative, zero or positive.
+func evaluateInstanceInfix(node *ast.Infix, left *object.Instance, right object.Object) object.Object {
+	name := operatorMethods[node.Operator]
+
+	if method, _ := left.Class.FindMethod(name); method == nil {
+		return newError(object.TypeError, node.Token, "unknown operator: %s %s %s, class %s does not define %s()", left.Class.Name.Value, node.Operator, right.Type(), left.Class.Name.Value, name)
+	}
+
+	result := callOperatorMethod(node.Token, left, name, right)
+
+	if isError(result) {
+		return result
+	}
+
+	switch name {
+	case "equals":
+		equal, ok := result.(*object.Boolean)
+
+		if !ok {
+			return newError(object.TypeError, node.Token, "equals() of class %s must return a boolean, got %s", left.Class.Name.Value, result.Type())
+		}
+
+		if node.Operator == "!=" {
+			return toBooleanValue(!equal.Value)
+		}
+
+		return toBooleanValue(equal.Value)
+	case "compare":
+		number, ok := result.(*object.Number)
+
+		if !ok {
+			return newError(object.TypeError, node.Token, "compare() of class %s must return a number, got %s", left.Class.Name.Value, result.Type())
+		}
+
+		sign := number.Value.Sign()
+
+		switch node.Operator {
+		case "<":
+			return toBooleanValue(sign < 0)
+		case "<=":
+			return toBooleanValue(sign <= 0)
+		case ">":
+			return toBooleanValue(sign > 0)
+		default:
+			return toBooleanValue(sign >= 0)
+		}
+	}
+
+	return result
+}
+
+// evaluateInstanceIndex evaluates instance[index] by calling the index()
+// method of the instance.
+func evaluateInstanceIndex(node *ast.Index, instance *object.Instance, index object.Object) object.Object {
+	if method, _ := instance.Class.FindMethod("index"); method == nil {
+		return newError(object.TypeError, node.Token, "index operator not supported: class %s does not define index()", instance.Class.Name.Value)
+	}
+
+	return callOperatorMethod(node.Token, instance, "index", index)
+}
+
+// evaluateInstanceIndexAssignment evaluates instance[index] = value by calling
+// the setIndex() method of the instance.
+func evaluateInstanceIndexAssignment(node *ast.Index, instance *object.Instance, index object.Object, assignmentValue object.Object) object.Object {
+	if method, _ := instance.Class.FindMethod("setIndex"); method == nil {
+		return newError(object.TypeError, node.Token, "index assignment not supported: class %s does not define setIndex()", instance.Class.Name.Value)
+	}
+
+	result := callOperatorMethod(node.Token, instance, "setIndex", index, assignmentValue)
+
+	if isError(result) {
+		return result
+	}
+
+	return nil
+}
+
+// callOperatorMethod calls the named method of the instance and returns its
+// result.
+func callOperatorMethod(tok token.Token, instance *object.Instance, name string, arguments ...object.Object) object.Object {
+	return unwrapReturn(instance.Call(name, arguments, tok))
+}
@@ -0,0 +1,44 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/value"
+)
+
+// shortCircuited is a null of its own type, so it can't be mistaken for null
+// values produced by the chain itself.
+type shortCircuited struct {
+	object.Null
+}
+
+// shortCircuit is passed along the links of a property, index, method and call
+// chain once a "?." finds null, skipping the rest of the chain. It never leaves
+// the chain, as Evaluate turns it back into null.
+var shortCircuit object.Object = &shortCircuited{}
+
+// evaluateChainLink evaluates the left side of a link in a chain, keeping the
+// short circuit of an earlier "?." in the same chain.
+func evaluateChainLink(node ast.Node, scope *object.Scope) object.Object {
+	switch node := node.(type) {
+	case *ast.Call:
+		return evaluateCall(node, scope)
+	case *ast.Index:
+		return evaluateIndex(node, scope)
+	case *ast.Method:
+		return evaluateMethod(node, scope)
+	case *ast.Property:
+		return evaluateProperty(node, scope)
+	}
+
+	return Evaluate(node, scope)
+}
+
+// endChain evaluates a short circuited chain to null.
+func endChain(result object.Object) object.Object {
+	if result == shortCircuit {
+		return value.NULL
+	}
+
+	return result
+}
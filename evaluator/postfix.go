@@ -6,49 +6,107 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// evaluatePostfix increments or decrements an identifier, index or property,
+// evaluating to its value from before the change. The operands of an index or
+// property are only evaluated once.
 func evaluatePostfix(node *ast.Postfix, scope *object.Scope) object.Object {
-	switch node.Operator {
-	case "++":
-		value, ok := scope.Environment.Get(node.Token.Lexeme)
+	switch target := node.Left.(type) {
+	case *ast.Identifier:
+		current, ok := scope.Environment.Get(target.Value)
 
 		if !ok {
-			return newError("%d:%d:%s: runtime error: identifier not found: %s", node.Token.Line, node.Token.Column, node.Token.File, node.Token.Lexeme)
+			return newError(object.NameError, node.Token, "identifier not found: %s", target.Value)
 		}
 
-		if value.Type() != object.NUMBER {
-			return newError("%d:%d:%s: runtime error: identifier is not a number: %s", node.Token.Line, node.Token.Column, node.Token.File, node.Token.Lexeme)
+		updated := evaluatePostfixValue(node, current)
+
+		if isError(updated) {
+			return updated
 		}
 
-		one := decimal.NewFromInt(1)
+		if err := evaluateIdentifierAssignment(target, updated, scope); err != nil {
+			return err
+		}
 
-		newValue := &object.Number{
-			Value: value.(*object.Number).Value.Add(one),
+		return current
+	case *ast.Index:
+		if target.Optional {
+			break
 		}
 
-		scope.Environment.Set(node.Token.Lexeme, newValue)
+		left := Evaluate(target.Left, scope)
 
-		return newValue
-	case "--":
-		value, ok := scope.Environment.Get(node.Token.Lexeme)
+		if isError(left) {
+			return left
+		}
 
-		if !ok {
-			return newError("%d:%d:%s: runtime error: identifier not found: %s", node.Token.Line, node.Token.Column, node.Token.File, node.Token.Lexeme)
+		index := Evaluate(target.Index, scope)
+
+		if isError(index) {
+			return index
 		}
 
-		if value.Type() != object.NUMBER {
-			return newError("%d:%d:%s: runtime error: identifier is not a number: %s", node.Token.Line, node.Token.Column, node.Token.File, node.Token.Lexeme)
+		current := evaluateIndexOf(target, left, index)
+		updated := evaluatePostfixValue(node, current)
+
+		if isError(updated) {
+			return updated
+		}
+
+		if err := assignIndex(target, left, index, updated); isError(err) {
+			return err
+		}
+
+		return current
+	case *ast.Property:
+		if target.Optional {
+			break
 		}
 
-		one := decimal.NewFromInt(1)
+		left := Evaluate(target.Left, scope)
 
-		newValue := &object.Number{
-			Value: value.(*object.Number).Value.Sub(one),
+		if isError(left) {
+			return left
 		}
 
-		scope.Environment.Set(node.Token.Lexeme, newValue)
+		current := evaluatePropertyOf(target, left, scope)
+		updated := evaluatePostfixValue(node, current)
+
+		if isError(updated) {
+			return updated
+		}
 
-		return newValue
-	default:
-		return newError("%d:%d:%s: runtime error: unknown operator: %s", node.Token.Line, node.Token.Column, node.Token.File, node.Operator)
+		if err := assignProperty(target, left, updated, scope); isError(err) {
+			return err
+		}
+
+		return current
+	}
+
+	return newError(object.TypeError, node.Token, "cannot assign to %s", node.Left.String())
+}
+
+// evaluatePostfixValue returns the current value of the target of a postfix
+// expression, incremented or decremented by one.
+func evaluatePostfixValue(node *ast.Postfix, current object.Object) object.Object {
+	if isError(current) {
+		return current
+	}
+
+	number, ok := current.(*object.Number)
+
+	if !ok {
+		return newError(object.TypeError, node.Token, "%s is not a number", node.Left.String())
 	}
+
+	one := decimal.NewFromInt(1)
+
+	switch node.Operator {
+	case "++":
+		return &object.Number{Value: number.Value.Add(one)}
+	case "--":
+		return &object.Number{Value: number.Value.Sub(one)}
+	}
+
+	return newError(object.TypeError, node.Token, "unknown operator: %s", node.Operator)
 }
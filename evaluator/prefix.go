@@ -4,6 +4,7 @@ import (
 	"ghostlang.org/x/ghost/ast"
 	"ghostlang.org/x/ghost/object"
 	"ghostlang.org/x/ghost/value"
+	"github.com/shopspring/decimal"
 )
 
 func evaluatePrefix(node *ast.Prefix, scope *object.Scope) object.Object {
@@ -28,13 +29,22 @@ func evaluatePrefix(node *ast.Prefix, scope *object.Scope) object.Object {
 	case "-":
 		// Only works with number objects
 		if right.Type() != object.NUMBER {
-			return newError("%d:%d:%s: runtime error: unknown operator: -%s", node.Token.Line, node.Token.Column, node.Token.File, right.Type())
+			return newError(object.TypeError, node.Token, "unknown operator: -%s", right.Type())
 		}
 
 		numberValue := right.(*object.Number).Value.Neg()
 
+		return &object.Number{Value: numberValue}
+	case "~":
+		// Only works with integral number objects
+		if right.Type() != object.NUMBER || !right.(*object.Number).Value.IsInteger() {
+			return newError(object.TypeError, node.Token, "unknown operator: ~%s", right.Type())
+		}
+
+		numberValue := decimal.NewFromInt(^right.(*object.Number).Value.IntPart())
+
 		return &object.Number{Value: numberValue}
 	}
 
-	return newError("%d:%d:%s: runtime error: unknown operator: %s%s", node.Token.Line, node.Token.Column, node.Token.File, node.Operator, right.Type())
+	return newError(object.TypeError, node.Token, "unknown operator: %s%s", node.Operator, right.Type())
 }
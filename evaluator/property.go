@@ -1,30 +1,82 @@
 package evaluator
 
 import (
+	"strings"
+
 	"ghostlang.org/x/ghost/ast"
 	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/token"
 	"ghostlang.org/x/ghost/value"
 )
 
 func evaluateProperty(node *ast.Property, scope *object.Scope) object.Object {
-	left := Evaluate(node.Left, scope)
+	left := evaluateChainLink(node.Left, scope)
 
-	if isError(left) {
+	if isError(left) || left == shortCircuit {
 		return left
 	}
 
+	if node.Optional && left.Type() == object.NULL {
+		return shortCircuit
+	}
+
+	return evaluatePropertyOf(node, left, scope)
+}
+
+// evaluatePropertyOf reads the property of an evaluated value.
+func evaluatePropertyOf(node *ast.Property, left object.Object, scope *object.Scope) object.Object {
 	switch left.(type) {
 	case *object.Instance:
-		return evaluateInstanceProperty(left, node)
+		return evaluateInstanceProperty(left, node, scope)
 	case *object.LibraryModule:
 		property := node.Property.(*ast.Identifier)
 		module := left.(*object.LibraryModule)
 
 		if function, ok := module.Properties[property.Value]; ok {
-			return unwrapCall(node.Token, function, nil, scope)
+			return unwrapCall(node.Token, function, nil, nil, scope)
 		}
 
-		return newError("%d:%d:%s: runtime error: unknown property: %s.%s", node.Token.Line, node.Token.Column, node.Token.File, module.Name, property.Value)
+		return newError(object.NameError, node.Token, "unknown property: %s.%s", module.Name, property.Value)
+	case *object.Exception:
+		property := node.Property.(*ast.Identifier)
+
+		if val, ok := left.(*object.Exception).Property(property.Value); ok {
+			return val
+		}
+
+		return newError(object.NameError, node.Token, "unknown property: %s.%s", left.Type(), property.Value)
+	case *object.Class:
+		property := node.Property.(*ast.Identifier)
+
+		if member, _ := left.(*object.Class).FindStatic(property.Value); member != nil {
+			return member
+		}
+
+		return newError(object.NameError, node.Token, "unknown property: %s.%s", left.(*object.Class).Name.Value, property.Value)
+	case *object.Enum:
+		property := node.Property.(*ast.Identifier)
+
+		if member, ok := left.(*object.Enum).Member(property.Value); ok {
+			return member
+		}
+
+		return newError(object.NameError, node.Token, "unknown member: %s.%s", left.(*object.Enum).Name, property.Value)
+	case *object.EnumMember:
+		property := node.Property.(*ast.Identifier)
+
+		if val, ok := left.(*object.EnumMember).Property(property.Value); ok {
+			return val
+		}
+
+		return newError(object.NameError, node.Token, "unknown property: %s.%s", left.String(), property.Value)
+	case *object.Range:
+		property := node.Property.(*ast.Identifier)
+
+		if val, ok := left.(*object.Range).Property(property.Value); ok {
+			return val
+		}
+
+		return newError(object.NameError, node.Token, "unknown property: %s.%s", left.Type(), property.Value)
 	case *object.Map:
 		property := &object.String{Value: node.Property.(*ast.Identifier).Value}
 		mapObj := left.(*object.Map)
@@ -38,38 +90,85 @@ func evaluateProperty(node *ast.Property, scope *object.Scope) object.Object {
 		return pair.Value
 	}
 
-	return nil
+	return newError(object.TypeError, node.Token, "cannot access property %s on %s", node.Property.(*ast.Identifier).Value, left.Type())
 }
 
-func evaluateInstanceProperty(left object.Object, node *ast.Property) object.Object {
-	var val object.Object
-
+// evaluateInstanceProperty reads the property of an instance, calling its
+// getter if the class defines one. Reading a property that was never assigned
+// is an error, as is reading a private property outside of the class.
+func evaluateInstanceProperty(left object.Object, node *ast.Property, scope *object.Scope) object.Object {
 	instance := left.(*object.Instance)
+
+	if val, ok := lookupInstanceProperty(node, instance, scope); ok {
+		return val
+	}
+
+	return newError(object.NameError, node.Token, "undefined property %s for class %s", node.Property.(*ast.Identifier).Value, instance.Class.Name.Value)
+}
+
+// lookupInstanceProperty reads the property of an instance like
+// evaluateInstanceProperty, but reports false instead of failing if the
+// property is undefined.
+func lookupInstanceProperty(node *ast.Property, instance *object.Instance, scope *object.Scope) (object.Object, bool) {
 	property := node.Property.(*ast.Identifier)
 
-	if instance.Environment.Has(property.Value) {
-		val, _ = instance.Environment.Get(property.Value)
+	if err := checkPrivateAccess(node, instance, scope); err != nil {
+		return err, true
+	}
 
-		return val
+	if isPrivate(property.Value) {
+		return instance.PrivateProperty(scope.Class, property.Value)
 	}
 
-	if instance.Class.Environment.Has(property.Value) {
-		val, _ = instance.Class.Environment.Get(property.Value)
+	if getter, definedIn := instance.Class.FindGetter(property.Value); getter != nil {
+		return callAccessor(node.Token, getter, instance, definedIn, property.Value, nil), true
+	}
 
-		return val
+	if instance.Environment.Has(property.Value) {
+		val, _ := instance.Environment.Get(property.Value)
+
+		return val, true
 	}
 
-	for _, trait := range instance.Class.Traits {
-		if trait.Environment.Has(property.Value) {
-			val, _ = trait.Environment.Get(property.Value)
+	if method, _ := instance.Class.FindMethod(property.Value); method != nil {
+		return method, true
+	}
 
-			return val
+	for _, trait := range instance.Class.Traits {
+		if val, ok := trait.Environment.All()[property.Value]; ok {
+			return val, true
 		}
 	}
 
-	instance.Environment.Set(property.Value, value.NULL)
+	return nil, false
+}
+
+// isPrivate determines if a property name is that of a private property.
+func isPrivate(name string) bool {
+	return strings.HasPrefix(name, "#")
+}
+
+// checkPrivateAccess ensures private properties are only accessed from within
+// the methods of a class the instance belongs to. Each class only reaches the
+// private properties its own methods assigned.
+func checkPrivateAccess(node *ast.Property, instance *object.Instance, scope *object.Scope) object.Object {
+	property := node.Property.(*ast.Identifier)
+
+	if !isPrivate(property.Value) {
+		return nil
+	}
+
+	if scope.Class != nil && isSubclass(instance.Class, scope.Class) {
+		return nil
+	}
+
+	return newError(object.RuntimeError, node.Token, "cannot access private property %s outside of class %s", property.Value, instance.Class.Name.Value)
+}
 
-	val, _ = instance.Environment.Get(property.Value)
+// callAccessor calls a getter or setter with "this" bound to the instance.
+func callAccessor(tok token.Token, accessor *object.Function, instance *object.Instance, definedIn *object.Class, name string, arguments []object.Object) object.Object {
+	env := createFunctionEnvironment(accessor, arguments)
+	scope := &object.Scope{Self: instance, Environment: env, Class: definedIn}
 
-	return val
+	return traceError(unwrapReturn(Evaluate(accessor.Body, scope)), definedIn.Name.Value+"."+name, tok)
 }
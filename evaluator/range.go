@@ -0,0 +1,45 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+	"github.com/shopspring/decimal"
+)
+
+func evaluateRange(node *ast.Range, scope *object.Scope) object.Object {
+	start := Evaluate(node.Start, scope)
+
+	if isError(start) {
+		return start
+	}
+
+	end := Evaluate(node.End, scope)
+
+	if isError(end) {
+		return end
+	}
+
+	if start.Type() != object.NUMBER || end.Type() != object.NUMBER {
+		return newError(object.TypeError, node.Token, "range bounds must be numbers, got %s..%s", start.Type(), end.Type())
+	}
+
+	step := decimal.NewFromInt(1)
+
+	if node.Step != nil {
+		evaluated := Evaluate(node.Step, scope)
+
+		if isError(evaluated) {
+			return evaluated
+		}
+
+		number, ok := evaluated.(*object.Number)
+
+		if !ok || !number.Value.IsPositive() {
+			return newError(object.TypeError, node.Token, "range step must be a positive number, got %s", evaluated.String())
+		}
+
+		step = number.Value
+	}
+
+	return object.NewRange(start.(*object.Number).Value, end.(*object.Number).Value, step)
+}
@@ -8,5 +8,9 @@ import (
 func evaluateReturn(node *ast.Return, scope *object.Scope) object.Object {
 	value := Evaluate(node.Value, scope)
 
+	if isError(value) {
+		return value
+	}
+
 	return &object.Return{Value: value}
 }
@@ -0,0 +1,47 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+)
+
+func evaluateSpread(node *ast.Spread, scope *object.Scope) object.Object {
+	return newError(object.RuntimeError, node.Token, "spread syntax is only allowed in calls, lists and maps")
+}
+
+// evaluateSpreadList evaluates a spread expression within a call or list
+// literal, whose value must be a list. Iterables such as ranges and generators
+// are consumed into a list.
+func evaluateSpreadList(node *ast.Spread, scope *object.Scope) object.Object {
+	value := Evaluate(node.Value, scope)
+
+	if isError(value) {
+		return value
+	}
+
+	if iterable, ok := value.(object.Iterable); ok {
+		return iterableToList(iterable)
+	}
+
+	if _, ok := value.(*object.List); !ok {
+		return newError(object.TypeError, node.Token, "cannot spread %s into a list", value.Type())
+	}
+
+	return value
+}
+
+// evaluateSpreadMap evaluates a spread expression within a map literal, whose
+// value must be a map.
+func evaluateSpreadMap(node *ast.Spread, scope *object.Scope) object.Object {
+	value := Evaluate(node.Value, scope)
+
+	if isError(value) {
+		return value
+	}
+
+	if _, ok := value.(*object.Map); !ok {
+		return newError(object.TypeError, node.Token, "cannot spread %s into a map", value.Type())
+	}
+
+	return value
+}
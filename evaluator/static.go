@@ -0,0 +1,62 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+)
+
+func evaluateStatic(node *ast.Static, scope *object.Scope) object.Object {
+	class, ok := scope.Self.(*object.Class)
+
+	if !ok {
+		return newError(object.RuntimeError, node.Token, "static members can only be declared in a class body")
+	}
+
+	var member object.Object
+
+	if function, ok := node.Value.(*ast.Function); ok {
+		member = newFunction(function, scope)
+	} else {
+		member = Evaluate(node.Value, scope)
+
+		if isError(member) {
+			return member
+		}
+	}
+
+	class.Static.Set(node.Name.Value, member)
+
+	return nil
+}
+
+// evaluateStaticMethod looks up the named static method starting at the
+// referenced class and evaluates it with "this" bound to the receiving class,
+// so inherited static methods see the class they were called on.
+func evaluateStaticMethod(node *ast.Method, receiver *object.Class, class *object.Class, name string, arguments []object.Object, named *object.Map, scope *object.Scope) object.Object {
+	member, definedIn := class.FindStatic(name)
+
+	if member == nil {
+		return newError(object.NameError, node.Token, "undefined method %s for class %s", name, receiver.Name.Value)
+	}
+
+	method, ok := member.(*object.Function)
+
+	if !ok {
+		return unwrapCall(node.Token, member, arguments, named, scope)
+	}
+
+	arguments, err := bindNamedArguments(node.Token, method, arguments, named)
+
+	if err != nil {
+		return err
+	}
+
+	env := createFunctionEnvironment(method, arguments)
+	methodScope := &object.Scope{Self: receiver, Environment: env, Class: definedIn}
+
+	if method.Generator {
+		return object.NewGenerator(method.Body, methodScope)
+	}
+
+	return traceError(unwrapReturn(Evaluate(method.Body, methodScope)), definedIn.Name.Value+"."+name, node.Token)
+}
@@ -0,0 +1,70 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+)
+
+func evaluateSuper(node *ast.Super, scope *object.Scope) object.Object {
+	return newError(object.RuntimeError, node.Token, "super can only be used to call a parent class method")
+}
+
+// evaluateSuperMethod calls the named method of the parent of the class the
+// current method was defined in, keeping "this" bound to the current instance.
+func evaluateSuperMethod(super *ast.Super, node *ast.Method, scope *object.Scope) object.Object {
+	if class, ok := scope.Self.(*object.Class); ok && scope.Class != nil {
+		return evaluateStaticSuperMethod(super, node, class, scope)
+	}
+
+	receiver, ok := scope.Self.(*object.Instance)
+
+	if !ok || scope.Class == nil {
+		return newError(object.RuntimeError, super.Token, "super can only be used within a class method")
+	}
+
+	if scope.Class.Super == nil {
+		return newError(object.RuntimeError, super.Token, "class %s does not extend a parent class", scope.Class.Name.Value)
+	}
+
+	arguments := evaluateExpressions(node.Arguments, scope)
+
+	if len(arguments) == 1 && isError(arguments[0]) {
+		return arguments[0]
+	}
+
+	named, err := evaluateNamedArguments(node.Named, scope)
+
+	if err != nil {
+		return err
+	}
+
+	method := node.Method.(*ast.Identifier)
+	evaluated := evaluateClassMethod(node, receiver, scope.Class.Super, method.Value, arguments, named)
+
+	return unwrapReturn(evaluated)
+}
+
+// evaluateStaticSuperMethod calls the named static method of the parent of the
+// class the current static method was defined in, keeping "this" bound to the
+// receiving class.
+func evaluateStaticSuperMethod(super *ast.Super, node *ast.Method, receiver *object.Class, scope *object.Scope) object.Object {
+	if scope.Class.Super == nil {
+		return newError(object.RuntimeError, super.Token, "class %s does not extend a parent class", scope.Class.Name.Value)
+	}
+
+	arguments := evaluateExpressions(node.Arguments, scope)
+
+	if len(arguments) == 1 && isError(arguments[0]) {
+		return arguments[0]
+	}
+
+	named, err := evaluateNamedArguments(node.Named, scope)
+
+	if err != nil {
+		return err
+	}
+
+	method := node.Method.(*ast.Identifier)
+
+	return evaluateStaticMethod(node, receiver, scope.Class.Super, method.Value, arguments, named, scope)
+}
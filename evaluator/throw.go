@@ -0,0 +1,75 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/value"
+)
+
+func evaluateThrow(node *ast.Throw, scope *object.Scope) object.Object {
+	thrown := Evaluate(node.Value, scope)
+
+	if isError(thrown) {
+		return thrown
+	}
+
+	if thrown == nil {
+		thrown = value.NULL
+	}
+
+	switch thrown := thrown.(type) {
+	case *object.Exception:
+		// Rethrow the original error, preserving where it occurred
+		return thrown.Error
+	case *object.String:
+		return newError(object.RuntimeError, node.Token, "%s", thrown.Value)
+	case *object.Map:
+		return evaluateThrowMap(node, thrown)
+	}
+
+	return newError(object.TypeError, node.Token, "throw expects a string, map or exception, got %s", thrown.Type())
+}
+
+// evaluateThrowMap raises a structured error from a map with a message, and
+// optionally a kind, a cause (a caught exception) and any additional data:
+//
+//	throw {kind: "ValidationError", message: "invalid email", data: input}
+func evaluateThrowMap(node *ast.Throw, thrown *object.Map) object.Object {
+	message, ok := mapValue(thrown, "message").(*object.String)
+
+	if !ok {
+		return newError(object.TypeError, node.Token, "thrown map must have a string message")
+	}
+
+	err := newError(object.RuntimeError, node.Token, "%s", message.Value)
+
+	switch kind := mapValue(thrown, "kind").(type) {
+	case nil:
+	case *object.String:
+		err.Kind = kind.Value
+	default:
+		return newError(object.TypeError, node.Token, "kind of thrown map must be a string, got %s", kind.Type())
+	}
+
+	switch cause := mapValue(thrown, "cause").(type) {
+	case nil, *object.Null:
+	case *object.Exception:
+		err.Cause = cause.Error
+	default:
+		return newError(object.TypeError, node.Token, "cause of thrown map must be an exception, got %s", cause.Type())
+	}
+
+	err.Data = mapValue(thrown, "data")
+
+	return err
+}
+
+// mapValue returns the value stored under the string key, or nil when the map
+// does not contain the key.
+func mapValue(mapObject *object.Map, key string) object.Object {
+	if pair, ok := mapObject.Pairs[(&object.String{Value: key}).MapKey()]; ok {
+		return pair.Value
+	}
+
+	return nil
+}
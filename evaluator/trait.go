@@ -1,6 +1,9 @@
 package evaluator
 
 import (
+	"sort"
+	"strings"
+
 	"ghostlang.org/x/ghost/ast"
 	"ghostlang.org/x/ghost/object"
 )
@@ -26,3 +29,101 @@ func evaluateTrait(node *ast.Trait, scope *object.Scope) object.Object {
 
 	return trait
 }
+
+// checkTraits ensures a class satisfies the contract of the traits it uses.
+// Every abstract method must be implemented, accepting at least as many
+// parameters as declared, and methods defined by more than one trait must be
+// defined by the class itself to resolve the conflict.
+func checkTraits(node *ast.Class, class *object.Class) object.Object {
+	tok := node.Name.Token
+	definedBy := make(map[string]*object.Trait)
+
+	for _, trait := range class.Traits {
+		names := make([]string, 0, len(trait.Environment.All()))
+
+		for name := range trait.Environment.All() {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			if _, ok := trait.FindMethod(name); !ok {
+				continue
+			}
+
+			if other, ok := definedBy[name]; ok && !definesMethod(class, name) {
+				return newError(object.RuntimeError, tok, "method %s is defined by both traits %s and %s, class %s must define it to resolve the conflict", name, other.Name.Value, trait.Name.Value, class.Name.Value)
+			}
+
+			definedBy[name] = trait
+		}
+	}
+
+	for _, trait := range class.Traits {
+		for _, abstract := range trait.Abstract {
+			method, _ := class.FindMethod(abstract.Name.Value)
+			function, ok := method.(*object.Function)
+
+			if !ok {
+				return newError(object.RuntimeError, tok, "class %s must implement method %s of trait %s", class.Name.Value, abstract.Name.Value, trait.Name.Value)
+			}
+
+			if function.Rest == nil && len(function.Parameters) < len(abstract.Parameters) {
+				parameters := make([]string, len(abstract.Parameters))
+
+				for index, parameter := range abstract.Parameters {
+					parameters[index] = parameter.Value
+				}
+
+				return newError(object.RuntimeError, tok, "method %s of class %s must accept the parameters (%s) of trait %s", abstract.Name.Value, class.Name.Value, strings.Join(parameters, ", "), trait.Name.Value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// evaluateTraitMethod calls a method of a trait used by the current class,
+// keeping "this" bound to the current instance. Classes use it to choose
+// between conflicting trait methods, as in Greeter.greet().
+func evaluateTraitMethod(node *ast.Method, trait *object.Trait, name string, arguments []object.Object, named *object.Map, scope *object.Scope) object.Object {
+	receiver, ok := scope.Self.(*object.Instance)
+
+	if !ok || scope.Class == nil || !scope.Class.Implements(trait) {
+		return newError(object.RuntimeError, node.Token, "methods of trait %s can only be called within a class using it", trait.Name.Value)
+	}
+
+	method, ok := trait.FindMethod(name)
+
+	if !ok {
+		return newError(object.NameError, node.Token, "undefined method %s for trait %s", name, trait.Name.Value)
+	}
+
+	arguments, err := bindNamedArguments(node.Token, method, arguments, named)
+
+	if err != nil {
+		return err
+	}
+
+	env := createFunctionEnvironment(method, arguments)
+	methodScope := &object.Scope{Self: receiver, Environment: env, Class: scope.Class}
+
+	if method.Generator {
+		return object.NewGenerator(method.Body, methodScope)
+	}
+
+	return traceError(unwrapReturn(Evaluate(method.Body, methodScope)), trait.Name.Value+"."+name, node.Token)
+}
+
+// definesMethod reports whether the class or one of its super classes defines
+// the named method in its own body.
+func definesMethod(class *object.Class, name string) bool {
+	for current := class; current != nil; current = current.Super {
+		if current.Environment.Has(name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,37 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+)
+
+func evaluateTry(node *ast.Try, scope *object.Scope) object.Object {
+	result := Evaluate(node.Body, scope)
+
+	if err, ok := result.(*object.Error); ok && node.Catch != nil {
+		result = evaluateCatch(node, err, scope)
+	}
+
+	if node.Finally != nil {
+		finally := Evaluate(node.Finally, scope)
+
+		// Errors and control flow raised within the finally block take
+		// precedence over the result of the try or catch blocks.
+		if isTerminator(finally) {
+			return finally
+		}
+	}
+
+	return result
+}
+
+func evaluateCatch(node *ast.Try, err *object.Error, scope *object.Scope) object.Object {
+	if node.Parameter == nil {
+		return Evaluate(node.Catch, scope)
+	}
+
+	catchScope := newBlockScope(scope)
+	catchScope.Environment.Bind(node.Parameter.Value, object.NewException(err))
+
+	return Evaluate(node.Catch, catchScope)
+}
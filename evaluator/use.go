@@ -10,14 +10,14 @@ func evaluateUse(node *ast.Use, scope *object.Scope) object.Object {
 	class, ok := scope.Self.(*object.Class)
 
 	if !ok {
-		return object.NewError("%d:%d:%s: runtime error: use statement can only be used in a class", node.Token.Line, node.Token.Column, node.Token.File)
+		return newError(object.RuntimeError, node.Token, "use statement can only be used in a class")
 	}
 
 	var traits []*object.Trait
 
 	for _, trait := range node.Traits {
 		if !scope.Environment.Has(trait.Value) {
-			return object.NewError("%d:%d:%s: runtime error: trait '%s' is not defined", trait.Token.Line, trait.Token.Column, trait.Token.File, trait.Value)
+			return newError(object.NameError, trait.Token, "trait '%s' is not defined", trait.Value)
 		}
 
 		identifier, _ := scope.Environment.Get(trait.Value)
@@ -25,13 +25,15 @@ func evaluateUse(node *ast.Use, scope *object.Scope) object.Object {
 		t, ok := identifier.(*object.Trait)
 
 		if !ok {
-			return object.NewError("%d:%d:%s: runtime error: referenced identifier in use not a trait, got=%T", trait.Token.Line, trait.Token.Column, trait.Token.File, trait)
+			return newError(object.TypeError, trait.Token, "referenced identifier in use not a trait, got=%T", trait)
 		}
 
-		traits = append(traits, t)
+		if !class.Implements(t) {
+			traits = append(traits, t)
+		}
 	}
 
-	class.Traits = traits
+	class.Traits = append(class.Traits, traits...)
 
 	return nil
 }
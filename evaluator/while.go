@@ -24,6 +24,8 @@ func evaluateWhile(node *ast.While, scope *object.Scope) object.Object {
 					//
 				case *object.Break:
 					return nil
+				case *object.Return:
+					return val
 				}
 			}
 		} else {
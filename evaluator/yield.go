@@ -0,0 +1,32 @@
+package evaluator
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/value"
+)
+
+// evaluateYield hands the value to the consumer of the enclosing generator,
+// suspending the generator until the next value is requested. If the
+// generator is closed instead, its body unwinds as if it had returned.
+func evaluateYield(node *ast.Yield, scope *object.Scope) object.Object {
+	if scope.Coroutine == nil {
+		return newError(object.RuntimeError, node.Token, "yield outside of a generator")
+	}
+
+	yielded := Evaluate(node.Value, scope)
+
+	if isError(yielded) {
+		return yielded
+	}
+
+	if yielded == nil {
+		yielded = value.NULL
+	}
+
+	if !scope.Coroutine.Yield(yielded) {
+		return &object.Return{Value: value.NULL}
+	}
+
+	return value.NULL
+}
@@ -0,0 +1,1007 @@
+// Package format prints Ghost source code in its canonical style: four space
+// indentation, opening braces on the same line as the statement they belong
+// to, single spaces around operators and only the parentheses that are
+// needed. Comments are kept next to the code they were written by.
+package format
+
+import (
+	"math"
+	"strings"
+
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/parser"
+	"ghostlang.org/x/ghost/scanner"
+	"ghostlang.org/x/ghost/token"
+)
+
+const indentation = "    "
+
+// bitwise contains the bitwise and shift operators.
+var bitwise = map[string]bool{"&": true, "|": true, "^": true, "<<": true, ">>": true}
+
+// primary is the precedence of expressions that never need parentheses, such
+// as literals, identifiers and calls.
+const primary = parser.INDEX + 1
+
+// Source formats the source code of a Ghost file. Source with syntax errors
+// can't be formatted, so its diagnostics are returned instead.
+func Source(source string, file string) (string, []*parser.Diagnostic) {
+	sourceScanner := scanner.New(source, file)
+	sourceParser := parser.New(sourceScanner)
+	program := sourceParser.Parse()
+
+	if diagnostics := sourceParser.Diagnostics(); len(diagnostics) > 0 {
+		return "", diagnostics
+	}
+
+	printer := &printer{source: sourceScanner, comments: sourceScanner.Comments()}
+	printer.lines(statementNodes(program.Statements), ast.Position{Line: math.MaxInt})
+
+	if printer.out.Len() == 0 {
+		return "", nil
+	}
+
+	return printer.out.String() + "\n", nil
+}
+
+// printer writes the formatted source of a syntax tree, interleaving the
+// comments of the source it was parsed from.
+type printer struct {
+	out       strings.Builder
+	source    *scanner.Scanner // Scanner the syntax tree was parsed with
+	depth     int              // Current level of indentation
+	lineStart bool             // Nothing has been written on the current line yet
+	comments  []token.Token    // Comments that still have to be printed
+	line      int              // Source line of the last item printed
+}
+
+// write writes text to the current line, indenting it first if it begins the
+// line.
+func (printer *printer) write(text string) {
+	if printer.lineStart && text != "" {
+		printer.out.WriteString(strings.Repeat(indentation, printer.depth))
+		printer.lineStart = false
+	}
+
+	printer.out.WriteString(text)
+}
+
+// newline ends the current line. Nothing is written before the first line.
+func (printer *printer) newline() {
+	if printer.out.Len() == 0 {
+		return
+	}
+
+	printer.out.WriteString("\n")
+	printer.lineStart = true
+}
+
+// beginLine starts the line of an item written on the given source line,
+// keeping a single blank line before it if the source had any.
+func (printer *printer) beginLine(line int, first bool) {
+	printer.newline()
+
+	if !first && line > printer.line+1 {
+		printer.newline()
+	}
+}
+
+// lines prints a list of statements or cases one per line, along with the
+// comments that come before each of them up until the end position.
+func (printer *printer) lines(nodes []ast.Node, end ast.Position) {
+	first := true
+
+	for index, node := range nodes {
+		span := node.Span()
+		next := end
+
+		if index < len(nodes)-1 {
+			next = nodes[index+1].Span().Start
+		}
+
+		first = printer.leadingComments(span.Start, first)
+		printer.beginLine(span.Start.Line, first)
+		printer.node(node)
+		printer.line = span.End.Line
+		printer.trailingComments(span.End, next)
+
+		first = false
+	}
+
+	printer.leadingComments(end, first)
+}
+
+// items prints comma separated items between an opening and closing
+// delimiter. Items written on lines of their own in the source are printed
+// one per line, otherwise they are all kept on the current line.
+func (printer *printer) items(opening string, closing string, nodes []ast.Node, multiline bool, end ast.Position, item func(int)) {
+	printer.write(opening)
+
+	if !multiline {
+		for index := range nodes {
+			if index > 0 {
+				printer.write(", ")
+			}
+
+			item(index)
+		}
+
+		printer.write(closing)
+
+		return
+	}
+
+	printer.depth++
+
+	first := true
+
+	for index, node := range nodes {
+		span := node.Span()
+		next := end
+
+		if index < len(nodes)-1 {
+			next = nodes[index+1].Span().Start
+		}
+
+		first = printer.leadingComments(span.Start, first)
+		printer.beginLine(span.Start.Line, first)
+		item(index)
+
+		if index < len(nodes)-1 {
+			printer.write(",")
+		}
+
+		printer.line = span.End.Line
+		printer.trailingComments(span.End, next)
+
+		first = false
+	}
+
+	printer.leadingComments(end, first)
+	printer.depth--
+	printer.newline()
+	printer.write(closing)
+}
+
+// =============================================================================
+// Comments
+
+// commentBefore reports whether the next comment to print begins before the
+// position.
+func (printer *printer) commentBefore(position ast.Position) bool {
+	return len(printer.comments) > 0 && before(start(printer.comments[0]), position)
+}
+
+// nextComment removes the next comment to print and returns its text.
+func (printer *printer) nextComment() token.Token {
+	comment := printer.comments[0]
+	printer.comments = printer.comments[1:]
+
+	if !strings.HasPrefix(comment.Lexeme, "/*") {
+		comment.Lexeme = strings.TrimRight(comment.Lexeme, " \t\r")
+	}
+
+	return comment
+}
+
+// inlineComment reports whether the next comment to print is a block comment
+// followed by more code on the line the position is on, such as the operand
+// after it. It is printed in place, right before the code at the position.
+func (printer *printer) inlineComment(position ast.Position) bool {
+	if !printer.commentBefore(position) || printer.source == nil {
+		return false
+	}
+
+	comment := printer.comments[0]
+
+	if !strings.HasPrefix(comment.Lexeme, "/*") || comment.EndLine != position.Line {
+		return false
+	}
+
+	line := []rune(printer.source.Line(comment.EndLine))
+	column := comment.EndColumn - 1
+
+	if column < 0 || column >= len(line) {
+		return false
+	}
+
+	rest := strings.TrimLeft(string(line[column:]), " \t")
+
+	return rest != "" && !strings.ContainsRune(")]}#", rune(rest[0])) && !strings.HasPrefix(rest, "//")
+}
+
+// inlineComments prints the block comments written in front of the code at
+// the position, on the same line.
+func (printer *printer) inlineComments(position ast.Position) {
+	for printer.inlineComment(position) {
+		printer.write(printer.nextComment().Lexeme + " ")
+	}
+}
+
+// commentsWithin reports whether any comments that still have to be printed
+// were written between the opening and closing positions, on separate lines.
+func (printer *printer) commentsWithin(opening ast.Position, closing ast.Position) bool {
+	if opening.Line == closing.Line {
+		return false
+	}
+
+	for _, comment := range printer.comments {
+		if !before(start(comment), closing) {
+			return false
+		}
+
+		if before(opening, start(comment)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// leadingComments prints the comments before the position on lines of their
+// own, except those written in front of the code at the position. It reports
+// whether the next item is still the first of its list.
+func (printer *printer) leadingComments(position ast.Position, first bool) bool {
+	for printer.commentBefore(position) && !printer.inlineComment(position) {
+		comment := printer.nextComment()
+
+		printer.beginLine(comment.Line, first)
+		printer.write(comment.Lexeme)
+		printer.line = comment.EndLine
+
+		first = false
+	}
+
+	return first
+}
+
+// trailingComments prints the comments following an item on the line it ends
+// on, as well as any comments left over from within the item, up until the
+// next item begins. Comments written in front of the next item are left to it.
+func (printer *printer) trailingComments(end ast.Position, next ast.Position) {
+	lineComment := false
+
+	for printer.commentBefore(next) && printer.comments[0].Line <= end.Line && !printer.inlineComment(next) {
+		comment := printer.nextComment()
+
+		// Nothing may follow a line comment on the same line
+		if lineComment {
+			printer.newline()
+		} else {
+			printer.write(" ")
+		}
+
+		printer.write(comment.Lexeme)
+		printer.line = max(printer.line, comment.EndLine)
+
+		lineComment = !strings.HasPrefix(comment.Lexeme, "/*")
+	}
+}
+
+// =============================================================================
+// Nodes
+
+// node prints a statement or expression, after the comments written in front
+// of it on the same line.
+func (printer *printer) node(node ast.Node) {
+	if node != nil {
+		printer.inlineComments(node.Span().Start)
+	}
+
+	switch node := node.(type) {
+	case *ast.Abstract:
+		printer.write(node.String())
+	case *ast.Accessor:
+		printer.write(node.Token.Lexeme + " " + node.Function.Name.Value)
+		printer.parameters(node.Function)
+		printer.write(" ")
+		printer.block(node.Function.Body)
+	case *ast.Assign:
+		printer.node(node.Name)
+		printer.write(" = ")
+		printer.node(node.Value)
+	case *ast.Block:
+		printer.block(node)
+	case *ast.Call:
+		printer.operand(node.Callee, precedence(node.Callee) < parser.CALL)
+		printer.arguments(node.Arguments, node.Named, start(node.Token), node.Closing)
+	case *ast.Case:
+		if node.Default {
+			printer.write("default ")
+		} else {
+			printer.write("case ")
+			printer.expressions(node.Value)
+			printer.write(" ")
+		}
+
+		printer.block(node.Body)
+	case *ast.Class:
+		printer.decorators(node.Decorators, node.Token)
+		printer.write("class " + node.Name.Value + " ")
+
+		if node.Super != nil {
+			printer.write("extends " + node.Super.Value + " ")
+		}
+
+		printer.block(node.Body)
+	case *ast.Compound:
+		printer.operand(node.Left, precedence(node.Left) < parser.ASSIGN)
+		printer.write(" " + node.Operator + " ")
+		printer.operand(node.Right, precedence(node.Right) <= parser.ASSIGN)
+	case *ast.Declaration:
+		printer.write(node.Token.Lexeme + " ")
+		printer.node(node.Name)
+
+		if node.Value != nil {
+			printer.write(" = ")
+			printer.node(node.Value)
+		}
+	case *ast.Enum:
+		printer.enum(node)
+	case *ast.EnumMember:
+		printer.write(node.Name.Value)
+
+		if hasToken(node.Closing) {
+			printer.arguments(nil, node.Values, end(node.Token), node.Closing)
+		}
+	case *ast.Expression:
+		printer.node(node.Expression)
+	case *ast.For:
+		printer.write("for (")
+		printer.node(node.Initializer)
+		printer.write("; ")
+		printer.node(node.Condition)
+		printer.write("; ")
+		printer.node(node.Increment)
+		printer.write(") ")
+		printer.block(node.Block)
+	case *ast.ForIn:
+		printer.write("for (")
+
+		if node.Key != nil && node.Key.Value != "" {
+			printer.write(node.Key.Value + ", ")
+		}
+
+		printer.node(node.Value)
+		printer.write(" in ")
+		printer.node(node.Iterable)
+		printer.write(") ")
+		printer.block(node.Block)
+	case *ast.Function:
+		printer.function(node)
+	case *ast.If:
+		printer.ifExpression(node)
+	case *ast.Index:
+		printer.operand(node.Left, precedence(node.Left) < parser.INDEX)
+
+		if node.Optional {
+			printer.write("?.")
+		}
+
+		printer.write("[")
+		printer.node(node.Index)
+		printer.write("]")
+	case *ast.Infix:
+		printer.infix(node)
+	case *ast.Interpolation:
+		// Comments in embedded expressions can only be kept as written
+		if fragmentsHaveComments(node.Token) {
+			printer.write(`"` + node.Token.Lexeme + `"`)
+
+			return
+		}
+
+		printer.write(`"`)
+
+		for _, part := range node.Parts {
+			if text, ok := part.(*ast.String); ok {
+				printer.write(strings.ReplaceAll(text.Value, "${", `\${`))
+			} else {
+				printer.write("${")
+				printer.node(part)
+				printer.write("}")
+			}
+		}
+
+		printer.write(`"`)
+	case *ast.List:
+		multiline := len(node.Elements) > 0 && node.Elements[0].Span().Start.Line > node.Token.Line || printer.commentsWithin(start(node.Token), start(node.Closing))
+
+		printer.items("[", "]", expressionNodes(node.Elements), multiline, start(node.Closing), func(index int) {
+			printer.node(node.Elements[index])
+		})
+	case *ast.Map:
+		printer.mapLiteral(node)
+	case *ast.Match:
+		printer.write("match (")
+		printer.node(node.Value)
+		printer.write(") {")
+		printer.cases(matchCaseNodes(node.Cases), node.Closing)
+	case *ast.MatchCase:
+		if node.Default {
+			printer.write("default ")
+		} else {
+			printer.write("case ")
+			printer.expressions(node.Patterns)
+
+			if node.Guard != nil {
+				printer.write(" if ")
+				printer.node(node.Guard)
+			}
+
+			printer.write(" ")
+		}
+
+		printer.block(node.Body)
+	case *ast.Method:
+		printer.operand(node.Left, precedence(node.Left) < parser.INDEX)
+		printer.write(node.Token.Lexeme)
+		printer.node(node.Method)
+		printer.arguments(node.Arguments, node.Named, node.Method.Span().End, node.Closing)
+	case *ast.NamedArgument:
+		printer.write(node.Name.Value + ": ")
+		printer.node(node.Value)
+	case *ast.Prefix:
+		printer.write(node.Operator)
+
+		// A minus sign before another would be read as a decrement
+		right, negated := node.Right.(*ast.Prefix)
+
+		printer.operand(node.Right, precedence(node.Right) < parser.PREFIX || negated && right.Operator == "-" && node.Operator == "-")
+	case *ast.Property:
+		printer.operand(node.Left, precedence(node.Left) < parser.INDEX)
+		printer.write(node.Token.Lexeme)
+		printer.node(node.Property)
+	case *ast.Range:
+		printer.operand(node.Start, precedence(node.Start) < parser.RANGE)
+		printer.write("..")
+		printer.operand(node.End, precedence(node.End) <= parser.RANGE)
+
+		if node.Step != nil {
+			printer.write(" step ")
+			printer.operand(node.Step, precedence(node.Step) <= parser.RANGE)
+		}
+	case *ast.Return:
+		if null, ok := node.Value.(*ast.Null); ok && null.Token == node.Token {
+			printer.write("return")
+		} else {
+			printer.write("return ")
+			printer.node(node.Value)
+		}
+	case *ast.String:
+		printer.stringLiteral(node)
+	case *ast.Spread:
+		printer.write("...")
+		printer.node(node.Value)
+	case *ast.Static:
+		printer.write("static ")
+
+		if function, ok := node.Value.(*ast.Function); ok {
+			printer.function(function)
+		} else if null, ok := node.Value.(*ast.Null); ok && null.Token == node.Name.Token {
+			printer.write(node.Name.Value)
+		} else {
+			printer.write(node.Name.Value + " = ")
+			printer.node(node.Value)
+		}
+	case *ast.Switch:
+		printer.write("switch (")
+		printer.node(node.Value)
+		printer.write(") {")
+		printer.cases(caseNodes(node.Cases), node.Closing)
+	case *ast.Ternary:
+		printer.operand(node.Condition, precedence(node.Condition) <= parser.TERNARY)
+		printer.write(" ? ")
+		printer.operand(node.IfTrue, precedence(node.IfTrue) <= parser.TERNARY)
+		printer.write(" : ")
+		printer.operand(node.IfFalse, precedence(node.IfFalse) <= parser.TERNARY)
+	case *ast.Throw:
+		printer.write("throw ")
+		printer.node(node.Value)
+	case *ast.Trait:
+		printer.write("trait " + node.Name.Value + " ")
+		printer.block(node.Body)
+	case *ast.Try:
+		printer.write("try ")
+		printer.block(node.Body)
+
+		if node.Catch != nil {
+			printer.write(" catch ")
+
+			if node.Parameter != nil {
+				printer.write("(" + node.Parameter.Value + ") ")
+			}
+
+			printer.block(node.Catch)
+		}
+
+		if node.Finally != nil {
+			printer.write(" finally ")
+			printer.block(node.Finally)
+		}
+	case *ast.While:
+		printer.write("while (")
+		printer.node(node.Condition)
+		printer.write(") ")
+		printer.block(node.Consequence)
+	case *ast.Yield:
+		if null, ok := node.Value.(*ast.Null); ok && null.Token == node.Token {
+			printer.write("yield")
+		} else {
+			printer.write("yield ")
+			printer.node(node.Value)
+		}
+	case nil:
+	default:
+		// Literals, identifiers, imports and patterns are printed the way the
+		// syntax tree represents them.
+		printer.write(node.String())
+	}
+}
+
+// stringLiteral prints a string in double quotes, unless it contains a double
+// quote itself, or was single quoted to keep a "${" from being read as an
+// embedded expression.
+func (printer *printer) stringLiteral(stringLiteral *ast.String) {
+	single := strings.Contains(stringLiteral.Value, `"`)
+
+	if strings.Contains(stringLiteral.Value, "${") && printer.source != nil {
+		line := []rune(printer.source.Line(stringLiteral.Token.Line))
+		column := stringLiteral.Token.Column - 1
+
+		single = single || column >= 0 && column < len(line) && line[column] == '\''
+	}
+
+	if single {
+		printer.write("'" + stringLiteral.Value + "'")
+	} else {
+		printer.write(`"` + strings.ReplaceAll(stringLiteral.Value, "${", `\${`) + `"`)
+	}
+}
+
+// operand prints an operand of an operator, in parentheses if it would
+// otherwise be read as part of a different expression.
+func (printer *printer) operand(node ast.Node, parenthesize bool) {
+	if parenthesize {
+		printer.write("(")
+		printer.node(node)
+		printer.write(")")
+
+		return
+	}
+
+	printer.node(node)
+}
+
+// infix prints an infix expression. Operators of the same precedence group
+// to the left, except for exponents which group to the right.
+func (printer *printer) infix(infix *ast.Infix) {
+	level := parser.Precedence(infix.Token.Type)
+	right := infix.Operator == "**"
+
+	printer.operand(infix.Left, precedence(infix.Left) < level || right && precedence(infix.Left) == level || mixesBitwise(infix, infix.Left))
+	printer.write(" " + infix.Operator + " ")
+	printer.operand(infix.Right, precedence(infix.Right) < level || !right && precedence(infix.Right) == level || mixesBitwise(infix, infix.Right))
+}
+
+// mixesBitwise reports whether an operand of a bitwise or shift operator uses
+// a different one of them. Their precedence is easily misremembered, so the
+// operand is kept in parentheses.
+func mixesBitwise(infix *ast.Infix, operand ast.Node) bool {
+	inner, ok := operand.(*ast.Infix)
+
+	return ok && inner.Operator != infix.Operator && bitwise[infix.Operator] && bitwise[inner.Operator]
+}
+
+// block prints a block on lines of its own. Empty blocks, and blocks holding
+// a single short statement that were written on one line, stay on one line.
+func (printer *printer) block(block *ast.Block) {
+	closing := start(block.Closing)
+
+	if !hasToken(block.Closing) {
+		closing = block.Span().End
+	}
+
+	if !printer.commentBefore(closing) && (len(block.Statements) == 0 || len(block.Statements) == 1 && block.Token.Line == block.Closing.Line) {
+		if len(block.Statements) == 0 {
+			printer.write("{}")
+
+			return
+		}
+
+		if statement := inline(block.Statements[0], printer.source); !strings.Contains(statement, "\n") {
+			printer.write("{ " + statement + " }")
+
+			return
+		}
+	}
+
+	printer.write("{")
+	printer.depth++
+	printer.line = block.Token.Line
+
+	if len(block.Statements) > 0 {
+		printer.trailingComments(end(block.Token), block.Statements[0].Span().Start)
+	}
+
+	printer.lines(statementNodes(block.Statements), closing)
+	printer.depth--
+	printer.newline()
+	printer.write("}")
+}
+
+// cases prints the cases of a switch statement or match expression, followed
+// by the closing brace.
+func (printer *printer) cases(cases []ast.Node, closing token.Token) {
+	printer.depth++
+	printer.lines(cases, start(closing))
+	printer.depth--
+	printer.newline()
+	printer.write("}")
+}
+
+// function prints a function declaration, a function literal or an arrow
+// function.
+func (printer *printer) function(function *ast.Function) {
+	printer.decorators(function.Decorators, function.Token)
+
+	if !function.Arrow {
+		printer.write("function")
+
+		if function.Name != nil {
+			printer.write(" " + function.Name.Value)
+		}
+
+		printer.parameters(function)
+		printer.write(" ")
+		printer.block(function.Body)
+
+		return
+	}
+
+	printer.parameters(function)
+	printer.write(" => ")
+
+	// Arrow functions returning an expression are written without a block
+	if function.Body.Token.Type == token.ARROW && len(function.Body.Statements) == 1 {
+		if returned, ok := function.Body.Statements[0].(*ast.Return); ok {
+			_, isMap := returned.Value.(*ast.Map)
+
+			printer.operand(returned.Value, isMap)
+
+			return
+		}
+	}
+
+	printer.block(function.Body)
+}
+
+// parameters prints the parenthesized parameters of a function, one per line
+// if comments were written between parameters on separate lines.
+func (printer *printer) parameters(function *ast.Function) {
+	nodes := []ast.Node{}
+
+	for _, parameter := range function.Parameters {
+		if value, ok := function.Defaults[parameter.Value]; ok {
+			nodes = append(nodes, &pair{key: parameter, value: value})
+		} else {
+			nodes = append(nodes, parameter)
+		}
+	}
+
+	if function.Rest != nil {
+		nodes = append(nodes, function.Rest)
+	}
+
+	// The parameters end where the body begins, with a "{" or "=>"
+	opening := start(function.Token)
+	closing := start(function.Body.Token)
+
+	if function.Arrow && hasToken(function.Opening) {
+		opening = start(function.Opening)
+	} else if function.Arrow && len(nodes) > 0 {
+		opening = nodes[0].Span().Start
+	}
+
+	multiline := printer.commentsWithin(opening, closing)
+
+	printer.items("(", ")", nodes, multiline, closing, func(index int) {
+		switch node := nodes[index].(type) {
+		case *pair:
+			printer.node(node.key)
+			printer.write(" = ")
+			printer.node(node.value)
+		default:
+			if node == function.Rest {
+				printer.inlineComments(function.Rest.Span().Start)
+				printer.write("...")
+			}
+
+			printer.node(node)
+		}
+	})
+}
+
+// decorators prints the decorators of a declaration, each on a line of its
+// own, ahead of the token the declaration begins with.
+func (printer *printer) decorators(decorators []*ast.Decorator, declaration token.Token) {
+	for index, decorator := range decorators {
+		printer.write("@")
+		printer.node(decorator.Expression)
+
+		next := start(declaration)
+
+		if index < len(decorators)-1 {
+			next = decorators[index+1].Span().Start
+		}
+
+		printer.trailingComments(decorator.Span().End, next)
+
+		printer.newline()
+	}
+}
+
+// arguments prints the parenthesized arguments of a call, one per line if the
+// first one was written on a line after the opening position, or if comments
+// were written between arguments on separate lines.
+func (printer *printer) arguments(arguments []ast.ExpressionNode, named []*ast.NamedArgument, opening ast.Position, closing token.Token) {
+	nodes := expressionNodes(arguments)
+
+	for _, argument := range named {
+		nodes = append(nodes, argument)
+	}
+
+	multiline := len(nodes) > 0 && nodes[0].Span().Start.Line > opening.Line || printer.commentsWithin(opening, start(closing))
+
+	printer.items("(", ")", nodes, multiline, start(closing), func(index int) {
+		printer.node(nodes[index])
+	})
+}
+
+// expressions prints a list of expressions separated by commas.
+func (printer *printer) expressions(expressions []ast.ExpressionNode) {
+	for index, expression := range expressions {
+		if index > 0 {
+			printer.write(", ")
+		}
+
+		printer.node(expression)
+	}
+}
+
+// ifExpression prints an if expression. An else branch holding nothing but
+// another if expression is printed as an else if.
+func (printer *printer) ifExpression(ifExpression *ast.If) {
+	printer.write("if (")
+	printer.node(ifExpression.Condition)
+	printer.write(") ")
+	printer.block(ifExpression.Consequence)
+
+	alternative := ifExpression.Alternative
+
+	if alternative == nil {
+		return
+	}
+
+	printer.write(" else ")
+
+	if !hasToken(alternative.Token) && len(alternative.Statements) == 1 {
+		printer.node(alternative.Statements[0])
+
+		return
+	}
+
+	printer.block(alternative)
+}
+
+// mapLiteral prints a map literal with its pairs in source order.
+func (printer *printer) mapLiteral(mapLiteral *ast.Map) {
+	pairs := make([]ast.Node, len(mapLiteral.Keys))
+
+	for index, key := range mapLiteral.Keys {
+		pairs[index] = key
+
+		if value, ok := mapLiteral.Pairs[key]; ok {
+			pairs[index] = &pair{key: key, value: value}
+		}
+	}
+
+	multiline := len(pairs) > 0 && pairs[0].Span().Start.Line > mapLiteral.Token.Line || printer.commentsWithin(start(mapLiteral.Token), start(mapLiteral.Closing))
+
+	printer.items("{", "}", pairs, multiline, start(mapLiteral.Closing), func(index int) {
+		key := mapLiteral.Keys[index]
+		value, ok := mapLiteral.Pairs[key]
+
+		printer.node(key)
+
+		// Spread expressions have no value, and {name} is short for
+		// {name: name}
+		if !ok || isShorthand(key, value) {
+			return
+		}
+
+		printer.write(": ")
+		printer.node(value)
+	})
+}
+
+// enum prints an enum declaration, with its members one per line if the
+// first one was written on a line of its own.
+func (printer *printer) enum(enum *ast.Enum) {
+	printer.write("enum " + enum.Name.Value + " ")
+
+	if len(enum.Members) == 0 && !printer.commentBefore(start(enum.Closing)) {
+		printer.write("{}")
+
+		return
+	}
+
+	members := make([]ast.Node, len(enum.Members))
+
+	for index, member := range enum.Members {
+		members[index] = member
+	}
+
+	multiline := len(members) == 0 || members[0].Span().Start.Line > enum.Name.Token.Line
+	opening, closing := "{ ", " }"
+
+	if multiline {
+		opening, closing = "{", "}"
+	}
+
+	printer.items(opening, closing, members, multiline, start(enum.Closing), func(index int) {
+		printer.node(members[index])
+	})
+}
+
+// =============================================================================
+// Helper functions
+
+// pair is a key and value of a map literal, or a parameter and its default
+// value, spanning both.
+type pair struct {
+	key   ast.Node
+	value ast.Node
+}
+
+func (pair *pair) Span() ast.Span {
+	return ast.Span{Start: pair.key.Span().Start, End: pair.value.Span().End}
+}
+
+func (pair *pair) String() string {
+	return pair.key.String() + ": " + pair.value.String()
+}
+
+// precedence returns how tightly an expression binds to its operands, which
+// decides whether it needs parentheses when used as an operand itself.
+func precedence(node ast.Node) int {
+	switch node := node.(type) {
+	case *ast.Infix:
+		return parser.Precedence(node.Token.Type)
+	case *ast.Prefix:
+		return parser.PREFIX
+	case *ast.Range:
+		return parser.RANGE
+	case *ast.Ternary:
+		return parser.TERNARY
+	case *ast.Compound:
+		return parser.ASSIGN
+	case *ast.Function:
+		// The body of an arrow function returning an expression extends as
+		// far as it can
+		if node.Arrow {
+			return parser.LOWEST
+		}
+	case *ast.Assign, *ast.Spread, *ast.Throw, *ast.Yield:
+		return parser.LOWEST
+	}
+
+	return primary
+}
+
+// inline prints a node without any comments, on its own.
+func inline(node ast.Node, source *scanner.Scanner) string {
+	printer := &printer{source: source}
+	printer.node(node)
+
+	return printer.out.String()
+}
+
+// isShorthand reports whether a map pair was written as {name}, which the
+// parser expands into a key and value for the same identifier token.
+func isShorthand(key ast.Node, value ast.Node) bool {
+	keyIdentifier, ok := key.(*ast.Identifier)
+
+	if !ok {
+		return false
+	}
+
+	valueIdentifier, ok := value.(*ast.Identifier)
+
+	return ok && keyIdentifier.Token == valueIdentifier.Token
+}
+
+// fragmentsHaveComments reports whether any expression embedded in an
+// interpolated string contains a comment.
+func fragmentsHaveComments(interpolation token.Token) bool {
+	fragments, _ := interpolation.Literal.([]token.Fragment)
+
+	for _, fragment := range fragments {
+		if !fragment.Expression {
+			continue
+		}
+
+		fragmentScanner := scanner.New(fragment.Value, interpolation.File)
+
+		// Scanning the whole fragment sets its comments aside
+		for fragmentScanner.ScanToken().Type != token.EOF {
+		}
+
+		if len(fragmentScanner.Comments()) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasToken reports whether a token was read from the source, rather than left
+// unset on a node the parser created itself.
+func hasToken(tok token.Token) bool {
+	return tok.Line > 0
+}
+
+// start returns the position a token begins at.
+func start(tok token.Token) ast.Position {
+	return ast.Position{Line: tok.Line, Column: tok.Column}
+}
+
+// end returns the position just past the end of a token.
+func end(tok token.Token) ast.Position {
+	return ast.Position{Line: tok.EndLine, Column: tok.EndColumn}
+}
+
+// before reports whether the first position comes before the second.
+func before(first ast.Position, second ast.Position) bool {
+	return first.Line < second.Line || first.Line == second.Line && first.Column < second.Column
+}
+
+func statementNodes(statements []ast.StatementNode) []ast.Node {
+	nodes := make([]ast.Node, len(statements))
+
+	for index, statement := range statements {
+		nodes[index] = statement
+	}
+
+	return nodes
+}
+
+func expressionNodes(expressions []ast.ExpressionNode) []ast.Node {
+	nodes := make([]ast.Node, len(expressions))
+
+	for index, expression := range expressions {
+		nodes[index] = expression
+	}
+
+	return nodes
+}
+
+func caseNodes(cases []*ast.Case) []ast.Node {
+	nodes := make([]ast.Node, len(cases))
+
+	for index, switchCase := range cases {
+		nodes[index] = switchCase
+	}
+
+	return nodes
+}
+
+func matchCaseNodes(cases []*ast.MatchCase) []ast.Node {
+	nodes := make([]ast.Node, len(cases))
+
+	for index, matchCase := range cases {
+		nodes[index] = matchCase
+	}
+
+	return nodes
+}
@@ -0,0 +1,139 @@
+package format
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"ghostlang.org/x/ghost/parser"
+	"ghostlang.org/x/ghost/scanner"
+)
+
+func TestSource(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"x=1+2*3", "x = 1 + 2 * 3\n"},
+		{"x = (1 + 2) * 3 - (4 - 5)", "x = (1 + 2) * 3 - (4 - 5)\n"},
+		{"x = ((a * b)) + c", "x = a * b + c\n"},
+		{"x = (2 ** 3) ** 2 + 2 ** (3 ** 2)", "x = (2 ** 3) ** 2 + 2 ** 3 ** 2\n"},
+		{"x = -(-y)", "x = -(-y)\n"},
+		{"x = (a << 1) | b", "x = (a << 1) | b\n"},
+		{"x = ((y) => y)(1)", "x = ((y) => y)(1)\n"},
+		{"make = () => ({name: 1})", "make = () => ({name: 1})\n"},
+		{"double = x => x * 2", "double = (x) => x * 2\n"},
+		{"print('hello', '${literal}', 'say \"hi\"')", "print(\"hello\", '${literal}', 'say \"hi\"')\n"},
+		{"function add(a,b){\nreturn a+b\n}", "function add(a, b) {\n    return a + b\n}\n"},
+		{"if (ready) { go() } else { wait() }", "if (ready) { go() } else { wait() }\n"},
+		{"if (ready) {\n  go()\n}\nelse if (later) {\n  wait()\n}", "if (ready) {\n    go()\n} else if (later) {\n    wait()\n}\n"},
+		{"while (true) { a(); b() }", "while (true) {\n    a()\n    b()\n}\n"},
+		{"for (i = 0; i < 3; i++) { }", "for (i = 0; i < 3; i++) {}\n"},
+		{"a = 1\n\n\n\nb = 2", "a = 1\n\nb = 2\n"},
+		{"list = [1,\n2]", "list = [1, 2]\n"},
+		{"list = [\n  1,\n  2\n]", "list = [\n    1,\n    2\n]\n"},
+		{"user = {\"name\": \"Ada\",\n  age}", "user = {\"name\": \"Ada\", age}\n"},
+		{"enum Level {\n  Debug,\n  Error(color: \"red\"),\n}", "enum Level {\n    Debug,\n    Error(color: \"red\")\n}\n"},
+		{"@memoize\nfunction fib(n) { return n }", "@memoize\nfunction fib(n) { return n }\n"},
+		{"class Dog extends Animal {\n  static count = 0\n  get name() {\n    return this.#name\n  }\n}", "class Dog extends Animal {\n    static count = 0\n    get name() {\n        return this.#name\n    }\n}\n"},
+		{"grade = match (score) {\n  case 90..100 { \"A\" }\n  default { \"B\" }\n}", "grade = match (score) {\n    case 90..100 { \"A\" }\n    default { \"B\" }\n}\n"},
+		{"// greet someone\nfunction greet(name) { // by name\n  # say hello\n  print(name) /* loudly */\n}", "// greet someone\nfunction greet(name) { // by name\n    # say hello\n    print(name) /* loudly */\n}\n"},
+		{"x = call(a, // first\n  b)\ny = 2", "x = call(\n    a, // first\n    b\n)\ny = 2\n"},
+		{"x = [1, // first\n 2, /* second */ 3]", "x = [\n    1, // first\n    2,\n    /* second */ 3\n]\n"},
+		{"user = {\"name\": \"Ada\", // known\n  age}", "user = {\n    \"name\": \"Ada\", // known\n    age\n}\n"},
+		{"f(1, /* inline */ 2)", "f(1, /* inline */ 2)\n"},
+		{"x = 1 + /* mid */ 2", "x = 1 + /* mid */ 2\n"},
+		{"/* first */ x = 1", "/* first */ x = 1\n"},
+		{"function f(a, /* mid */ b) { return a + b }", "function f(a, /* mid */ b) { return a + b }\n"},
+		{"add = (a, /* mid */ b) => a + b", "add = (a, /* mid */ b) => a + b\n"},
+		{"function f(a, // first\n  b = 2, /* rest */ ...more) {\n  return a\n}", "function f(\n    a, // first\n    b = 2,\n    /* rest */ ...more\n) {\n    return a\n}\n"},
+		{"items = [\n  1, // one\n  // two next\n  2\n]", "items = [\n    1, // one\n    // two next\n    2\n]\n"},
+		{"function todo() {\n  // nothing yet\n}", "function todo() {\n    // nothing yet\n}\n"},
+		{"x = 1 // one   \n// the end", "x = 1 // one\n// the end\n"},
+		{"print(\"${a /* kept */ +b}\")", "print(\"${a /* kept */ +b}\")\n"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		formatted, diagnostics := Source(tt.input, "test.ghost")
+
+		if len(diagnostics) > 0 {
+			t.Fatalf("could not format %q: %s", tt.input, diagnostics[0].String())
+		}
+
+		if formatted != tt.expected {
+			t.Errorf("formatting %q is wrong. expected=%q, got=%q", tt.input, tt.expected, formatted)
+		}
+	}
+}
+
+func TestSourceWithSyntaxErrors(t *testing.T) {
+	formatted, diagnostics := Source("x = (1 + \ny = 2", "test.ghost")
+
+	if len(diagnostics) == 0 {
+		t.Fatalf("source with syntax errors should not be formatted. got=%q", formatted)
+	}
+
+	if formatted != "" {
+		t.Errorf("source with syntax errors should not be formatted. got=%q", formatted)
+	}
+}
+
+func TestFormattingExamples(t *testing.T) {
+	files, err := filepath.Glob("../examples/*.ghost")
+
+	if err != nil || len(files) == 0 {
+		t.Fatalf("could not find examples: %v", err)
+	}
+
+	for _, file := range files {
+		source, err := os.ReadFile(file)
+
+		if err != nil {
+			t.Fatalf("could not read %s: %s", file, err)
+		}
+
+		formatted, diagnostics := Source(string(source), file)
+
+		if len(diagnostics) > 0 {
+			continue
+		}
+
+		if expected, got := parse(t, string(source)), parse(t, formatted); got != expected {
+			t.Errorf("formatting %s changed its meaning:\n%s", file, formatted)
+		}
+
+		if again, _ := Source(formatted, file); again != formatted {
+			t.Errorf("formatting %s again changed it:\n%s\n----\n%s", file, formatted, again)
+		}
+
+		if expected, got := comments(string(source)), comments(formatted); got != expected {
+			t.Errorf("formatting %s lost comments. expected=%d, got=%d\n%s", file, expected, got, formatted)
+		}
+	}
+}
+
+// =============================================================================
+// Helper functions
+
+// parse parses source and represents it on a single line, which differs
+// between two sources only if they differ in meaning.
+func parse(t *testing.T, source string) string {
+	sourceParser := parser.New(scanner.New(source, "test.ghost"))
+	program := sourceParser.Parse()
+
+	if len(sourceParser.Errors()) > 0 {
+		t.Errorf("could not parse:\n%s\n%v", source, sourceParser.Errors())
+	}
+
+	return program.String()
+}
+
+// comments counts the comments in source.
+func comments(source string) int {
+	sourceScanner := scanner.New(source, "test.ghost")
+	sourceParser := parser.New(sourceScanner)
+	sourceParser.Parse()
+
+	return len(sourceScanner.Comments())
+}
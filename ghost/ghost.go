@@ -13,10 +13,11 @@ import (
 )
 
 type Ghost struct {
-	FatalError bool
-	source     string
-	file       string
-	Scope      *object.Scope
+	FatalError  bool
+	source      string
+	file        string
+	diagnostics []*parser.Diagnostic
+	Scope       *object.Scope
 }
 
 var (
@@ -63,21 +64,29 @@ func (ghost *Ghost) SetFile(file string) {
 	ghost.file = file
 }
 
+// Diagnostics returns the syntax errors found in the source by the last call
+// to Execute. The source is only evaluated if there are none.
+func (ghost *Ghost) Diagnostics() []*parser.Diagnostic {
+	return ghost.diagnostics
+}
+
 func (ghost *Ghost) Execute() object.Object {
 	scanner := scanner.New(ghost.source, ghost.file)
 	parser := parser.New(scanner)
 	program := parser.Parse()
 
-	if len(parser.Errors()) != 0 {
-		logParseErrors(parser.Errors())
+	ghost.diagnostics = parser.Diagnostics()
+
+	if len(ghost.diagnostics) != 0 {
+		logDiagnostics(ghost.diagnostics)
 
-		return object.NewError(parser.Errors()[0])
+		return evaluator.SyntaxError(ghost.diagnostics)
 	}
 
 	result := evaluator.Evaluate(program, ghost.Scope)
 
 	if object.IsError(result) {
-		log.Error(result.(*object.Error).Message)
+		log.Error("%s", result.(*object.Error).Trace())
 	}
 
 	return result
@@ -87,6 +96,12 @@ func RegisterFunction(name string, function object.GoFunction) {
 	library.RegisterFunction(name, function)
 }
 
+// RegisterNamedFunction registers a function that accepts named arguments,
+// which are passed to it as a trailing map argument.
+func RegisterNamedFunction(name string, function object.GoFunction) {
+	library.RegisterNamedFunction(name, function)
+}
+
 func RegisterModule(name string, methods map[string]*object.LibraryFunction, properties map[string]*object.LibraryProperty) {
 	library.RegisterModule(name, methods, properties)
 }
@@ -103,8 +118,8 @@ func (ghost *Ghost) registerEvaluator() {
 	modules.RegisterEvaluator(evaluatorInstance)
 }
 
-func logParseErrors(errors []string) {
-	for _, message := range errors {
-		log.Error(message)
+func logDiagnostics(diagnostics []*parser.Diagnostic) {
+	for _, diagnostic := range diagnostics {
+		log.Error("%s\n%s", diagnostic.String(), diagnostic.Snippet())
 	}
 }
@@ -9,7 +9,7 @@ import (
 
 func Type(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
 	if len(args) != 1 {
-		return object.NewError("%d:%d: runtime error: type() expects 1 argument. got=%d", tok.Line, tok.Column, len(args))
+		return object.NewErrorAt(object.ArgumentError, tok, "type() expects 1 argument. got=%d", len(args))
 	}
 
 	objectType := string(args[0].Type())
@@ -17,6 +17,7 @@ func init() {
 	RegisterModule("math", modules.MathMethods, modules.MathProperties)
 	RegisterModule("os", modules.OsMethods, modules.OsProperties)
 	RegisterModule("random", modules.RandomMethods, modules.RandomProperties)
+	RegisterModule("reflect", modules.ReflectMethods, modules.ReflectProperties)
 	RegisterModule("time", modules.TimeMethods, modules.TimeProperties)
 	RegisterModule("json", modules.JsonMethods, modules.JsonProperties)
 
@@ -28,6 +29,12 @@ func RegisterFunction(name string, function object.GoFunction) {
 	Functions[name] = &object.LibraryFunction{Name: name, Function: function}
 }
 
+// RegisterNamedFunction registers a library function that accepts named
+// arguments, which are passed to it as a trailing map argument.
+func RegisterNamedFunction(name string, function object.GoFunction) {
+	Functions[name] = &object.LibraryFunction{Name: name, Function: function, NamedArguments: true}
+}
+
 func RegisterModule(name string, methods map[string]*object.LibraryFunction, properties map[string]*object.LibraryProperty) {
 	Modules[name] = &object.LibraryModule{Name: name, Methods: methods, Properties: properties}
 }
@@ -26,28 +26,32 @@ func init() {
 
 func ghostAbort(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
 	if len(args) != 1 {
-		return object.NewError("%d:%d: runtime error: ghost.abort() expects 1 argument. got=%d", tok.Line, tok.Column, len(args))
+		return object.NewErrorAt(object.ArgumentError, tok, "ghost.abort() expects 1 argument. got=%d", len(args))
 	}
 
 	switch obj := args[0].(type) {
 	case *object.Null:
 		return nil
 	case *object.String:
-		return object.NewError(obj.Value)
+		// The message is reported as is, without the position prefix
+		err := object.NewErrorAt(object.RuntimeError, tok, "%s", obj.Value)
+		err.Message = obj.Value
+
+		return err
 	}
 
-	return object.NewError("%d:%d: runtime error: ghost.abort() expects the first argument to be of type 'null' or 'string'. got=%s", tok.Line, tok.Column, strings.ToLower(string(args[0].Type())))
+	return object.NewErrorAt(object.TypeError, tok, "ghost.abort() expects the first argument to be of type 'null' or 'string'. got=%s", strings.ToLower(string(args[0].Type())))
 }
 
 func ghostExecute(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
 	if len(args) != 1 {
-		return object.NewError("%d:%d: runtime error: ghost.execute() expects 1 argument. got=%d", tok.Line, tok.Column, len(args))
+		return object.NewErrorAt(object.ArgumentError, tok, "ghost.execute() expects 1 argument. got=%d", len(args))
 	}
 
 	source, ok := args[0].(*object.String)
 
 	if !ok {
-		return object.NewError("%d:%d: runtime error: ghost.execute() expects the first argument to be of type 'string'. got=%s", tok.Line, tok.Column, strings.ToLower(string(args[0].Type())))
+		return object.NewErrorAt(object.TypeError, tok, "ghost.execute() expects the first argument to be of type 'string'. got=%s", strings.ToLower(string(args[0].Type())))
 	}
 
 	scanner := scanner.New(source.Value, tok.File)
@@ -59,13 +63,13 @@ func ghostExecute(scope *object.Scope, tok token.Token, args ...object.Object) o
 
 func ghostExtend(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
 	if len(args) != 1 {
-		return object.NewError("%d:%d: runtime error: ghost.extend() expects 1 argument. got=%d", tok.Line, tok.Column, len(args))
+		return object.NewErrorAt(object.ArgumentError, tok, "ghost.extend() expects 1 argument. got=%d", len(args))
 	}
 
 	basePath, ok := args[0].(*object.String)
 
 	if !ok {
-		return object.NewError("%d:%d: runtime error: ghost.extend() expects the first argument to be of type 'string'. got=%s", tok.Line, tok.Column, strings.ToLower(string(args[0].Type())))
+		return object.NewErrorAt(object.TypeError, tok, "ghost.extend() expects the first argument to be of type 'string'. got=%s", strings.ToLower(string(args[0].Type())))
 	}
 
 	path := path.Clean(scope.Environment.GetDirectory() + "/" + basePath.Value)
@@ -73,13 +77,13 @@ func ghostExtend(scope *object.Scope, tok token.Token, args ...object.Object) ob
 	extension, err := plugin.Open(path)
 
 	if err != nil {
-		return object.NewError("%d:%d: runtime error: ghost.extend() failed opening plugin: %s", tok.Line, tok.Column, err)
+		return object.NewErrorAt(object.ImportError, tok, "ghost.extend() failed opening plugin: %s", err)
 	}
 
 	register, err := extension.Lookup("Register")
 
 	if err != nil {
-		return object.NewError("%d:%d: runtime error: plugin '%s' does not contain Register function: %s", tok.Line, tok.Column, path, err)
+		return object.NewErrorAt(object.ImportError, tok, "plugin '%s' does not contain Register function: %s", path, err)
 	}
 
 	register.(func())()
@@ -89,7 +93,7 @@ func ghostExtend(scope *object.Scope, tok token.Token, args ...object.Object) ob
 
 func ghostIdentifiers(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
 	if len(args) != 0 {
-		return object.NewError("%d:%d: runtime error: ghost.identifiers() expects 0 arguments. got=%d", tok.Line, tok.Column, len(args))
+		return object.NewErrorAt(object.ArgumentError, tok, "ghost.identifiers() expects 0 arguments. got=%d", len(args))
 	}
 
 	identifiers := []object.Object{}
@@ -1,6 +1,7 @@
 package modules
 
 import (
+	"bufio"
 	"io/ioutil"
 	"os"
 	"path"
@@ -15,25 +16,26 @@ var IoProperties = map[string]*object.LibraryProperty{}
 
 func init() {
 	RegisterMethod(IoMethods, "append", ioAppend)
+	RegisterMethod(IoMethods, "lines", ioLines)
 	RegisterMethod(IoMethods, "read", ioRead)
 	RegisterMethod(IoMethods, "write", ioWrite)
 }
 
 func ioAppend(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
 	if len(args) != 2 {
-		return object.NewError("%d:%d: runtime error: io.append() expects 2 arguments. got=%d", tok.Line, tok.Column, len(args))
+		return object.NewErrorAt(object.ArgumentError, tok, "io.append() expects 2 arguments. got=%d", len(args))
 	}
 
 	basePath, ok := args[0].(*object.String)
 
 	if !ok {
-		return object.NewError("%d:%d: runtime error: io.append() expects first argument to be of type 'string'. got=%s", tok.Line, tok.Column, strings.ToLower(string(args[0].Type())))
+		return object.NewErrorAt(object.TypeError, tok, "io.append() expects first argument to be of type 'string'. got=%s", strings.ToLower(string(args[0].Type())))
 	}
 
 	content, ok := args[1].(*object.String)
 
 	if !ok {
-		return object.NewError("%d:%d: runtime error: io.append() expects second argument to be of type 'string'. got=%s", tok.Line, tok.Column, strings.ToLower(string(args[1].Type())))
+		return object.NewErrorAt(object.TypeError, tok, "io.append() expects second argument to be of type 'string'. got=%s", strings.ToLower(string(args[1].Type())))
 	}
 
 	cleanPath := path.Clean(scope.Environment.GetDirectory() + "/" + basePath.Value)
@@ -41,7 +43,7 @@ func ioAppend(scope *object.Scope, tok token.Token, args ...object.Object) objec
 	file, err := os.OpenFile(cleanPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 
 	if err != nil {
-		return object.NewError("%d:%d: runtime error: io.append() %s", tok.Line, tok.Column, err)
+		return object.NewErrorAt(object.IOError, tok, "io.append() %s", err)
 	}
 
 	defer file.Close()
@@ -51,22 +53,63 @@ func ioAppend(scope *object.Scope, tok token.Token, args ...object.Object) objec
 	return nil
 }
 
+// ioLines returns an iterator over the lines of a file, reading each line only
+// once it is requested.
+func ioLines(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return object.NewErrorAt(object.ArgumentError, tok, "io.lines() expects 1 argument. got=%d", len(args))
+	}
+
+	basePath, ok := args[0].(*object.String)
+
+	if !ok {
+		return object.NewErrorAt(object.TypeError, tok, "io.lines() expects first argument to be of type 'string'. got=%s", strings.ToLower(string(args[0].Type())))
+	}
+
+	path := path.Clean(scope.Environment.GetDirectory() + "/" + basePath.Value)
+	file, err := os.Open(path)
+
+	if err != nil {
+		return object.NewErrorAt(object.IOError, tok, "io.lines() %s", err)
+	}
+
+	lines := bufio.NewScanner(file)
+
+	return &object.LibraryIterator{
+		Name: "io.lines",
+		Function: func() (object.Object, bool) {
+			if lines.Scan() {
+				return &object.String{Value: lines.Text()}, false
+			}
+
+			if err := lines.Err(); err != nil {
+				return object.NewErrorAt(object.IOError, tok, "io.lines() %s", err), true
+			}
+
+			return nil, true
+		},
+		Release: func() {
+			file.Close()
+		},
+	}
+}
+
 func ioRead(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
 	if len(args) != 1 {
-		return object.NewError("%d:%d: runtime error: io.read() expects 1 argument. got=%d", tok.Line, tok.Column, len(args))
+		return object.NewErrorAt(object.ArgumentError, tok, "io.read() expects 1 argument. got=%d", len(args))
 	}
 
 	basePath, ok := args[0].(*object.String)
 
 	if !ok {
-		return object.NewError("%d:%d: runtime error: io.read() expects first argument to be of type 'string'. got=%s", tok.Line, tok.Column, strings.ToLower(string(args[0].Type())))
+		return object.NewErrorAt(object.TypeError, tok, "io.read() expects first argument to be of type 'string'. got=%s", strings.ToLower(string(args[0].Type())))
 	}
 
 	path := path.Clean(scope.Environment.GetDirectory() + "/" + basePath.Value)
 	content, err := ioutil.ReadFile(path)
 
 	if err != nil {
-		return object.NewError("%d:%d: runtime error: io.read() %s", tok.Line, tok.Column, err)
+		return object.NewErrorAt(object.IOError, tok, "io.read() %s", err)
 	}
 
 	return &object.String{Value: string(content)}
@@ -74,19 +117,19 @@ func ioRead(scope *object.Scope, tok token.Token, args ...object.Object) object.
 
 func ioWrite(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
 	if len(args) != 2 {
-		return object.NewError("%d:%d: runtime error: io.write() expects 2 arguments. got=%d", tok.Line, tok.Column, len(args))
+		return object.NewErrorAt(object.ArgumentError, tok, "io.write() expects 2 arguments. got=%d", len(args))
 	}
 
 	basePath, ok := args[0].(*object.String)
 
 	if !ok {
-		return object.NewError("%d:%d: runtime error: io.write() expects first argument to be of type 'string'. got=%s", tok.Line, tok.Column, strings.ToLower(string(args[0].Type())))
+		return object.NewErrorAt(object.TypeError, tok, "io.write() expects first argument to be of type 'string'. got=%s", strings.ToLower(string(args[0].Type())))
 	}
 
 	content, ok := args[1].(*object.String)
 
 	if !ok {
-		return object.NewError("%d:%d: runtime error: io.write() expects second argument to be of type 'string'. got=%s", tok.Line, tok.Column, strings.ToLower(string(args[1].Type())))
+		return object.NewErrorAt(object.TypeError, tok, "io.write() expects second argument to be of type 'string'. got=%s", strings.ToLower(string(args[1].Type())))
 	}
 
 	path := path.Clean(scope.Environment.GetDirectory() + "/" + basePath.Value)
@@ -94,7 +137,7 @@ func ioWrite(scope *object.Scope, tok token.Token, args ...object.Object) object
 	info, err := os.Stat(path)
 
 	if err != nil {
-		return object.NewError("%d:%d: runtime error: io.write() %s", tok.Line, tok.Column, err)
+		return object.NewErrorAt(object.IOError, tok, "io.write() %s", err)
 	}
 
 	mode := info.Mode()
@@ -102,7 +145,7 @@ func ioWrite(scope *object.Scope, tok token.Token, args ...object.Object) object
 	err = ioutil.WriteFile(path, contents, mode)
 
 	if err != nil {
-		return object.NewError("%d:%d: runtime error: io.write() %s", tok.Line, tok.Column, err)
+		return object.NewErrorAt(object.IOError, tok, "io.write() %s", err)
 	}
 
 	return nil
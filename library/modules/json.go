@@ -3,6 +3,7 @@ package modules
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"ghostlang.org/x/ghost/object"
 	"ghostlang.org/x/ghost/token"
@@ -19,13 +20,13 @@ func init() {
 // jsonDecode decodes the JSON-encoded data and returns a new list or map object.
 func jsonDecode(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
 	if len(args) != 1 {
-		return object.NewError("wrong number of arguments. got=%d, want=1", len(args))
+		return object.NewErrorAt(object.ArgumentError, tok, "json.decode() expects 1 argument. got=%d", len(args))
 	}
 
 	str, ok := args[0].(*object.String)
 
 	if !ok {
-		return object.NewError("argument to `decode` must be STRING, got %s", args[0].Type())
+		return object.NewErrorAt(object.TypeError, tok, "json.decode() expects the first argument to be of type 'string'. got=%s", strings.ToLower(string(args[0].Type())))
 	}
 
 	var data interface{}
@@ -33,7 +34,7 @@ func jsonDecode(scope *object.Scope, tok token.Token, args ...object.Object) obj
 	err := json.Unmarshal([]byte(str.Value), &data)
 
 	if err != nil {
-		return object.NewError("failed to decode JSON: %s", err.Error())
+		return object.NewErrorAt(object.RuntimeError, tok, "failed to decode JSON: %s", err.Error())
 	}
 
 	switch v := data.(type) {
@@ -58,13 +59,13 @@ func jsonDecode(scope *object.Scope, tok token.Token, args ...object.Object) obj
 		return &object.Map{Pairs: pairs}
 	}
 
-	return object.NewError("failed to decode JSON: %s", err.Error())
+	return object.NewErrorAt(object.RuntimeError, tok, "failed to decode JSON: expected a list or map")
 }
 
 // jsonEncode returns the JSON encoding of either a list or map object.
 func jsonEncode(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
 	if len(args) != 1 {
-		return object.NewError("wrong number of arguments. got=%d, want=1", len(args))
+		return object.NewErrorAt(object.ArgumentError, tok, "json.encode() expects 1 argument. got=%d", len(args))
 	}
 
 	switch arg := args[0].(type) {
@@ -78,7 +79,7 @@ func jsonEncode(scope *object.Scope, tok token.Token, args ...object.Object) obj
 		data, err := json.Marshal(elements)
 
 		if err != nil {
-			return object.NewError("failed to encode JSON: %s", err.Error())
+			return object.NewErrorAt(object.RuntimeError, tok, "failed to encode JSON: %s", err.Error())
 		}
 
 		return &object.String{Value: string(data)}
@@ -100,11 +101,11 @@ func jsonEncode(scope *object.Scope, tok token.Token, args ...object.Object) obj
 		data, err := json.Marshal(pairs)
 
 		if err != nil {
-			return object.NewError("failed to encode JSON: %s", err.Error())
+			return object.NewErrorAt(object.RuntimeError, tok, "failed to encode JSON: %s", err.Error())
 		}
 
 		return &object.String{Value: string(data)}
 	}
 
-	return object.NewError("argument to `encode` must be LIST or MAP, got %s", args[0].Type())
+	return object.NewErrorAt(object.TypeError, tok, "json.encode() expects the first argument to be of type 'list' or 'map'. got=%s", strings.ToLower(string(args[0].Type())))
 }
@@ -11,6 +11,12 @@ func RegisterMethod(methods map[string]*object.LibraryFunction, name string, fun
 	methods[name] = &object.LibraryFunction{Name: name, Function: function}
 }
 
+// RegisterNamedMethod registers a module method that accepts named arguments,
+// which are passed to it as a trailing map argument.
+func RegisterNamedMethod(methods map[string]*object.LibraryFunction, name string, function object.GoFunction) {
+	methods[name] = &object.LibraryFunction{Name: name, Function: function, NamedArguments: true}
+}
+
 func RegisterProperty(properties map[string]*object.LibraryProperty, name string, property object.GoProperty) {
 	properties[name] = &object.LibraryProperty{Name: name, Property: property}
 }
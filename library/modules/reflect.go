@@ -0,0 +1,317 @@
+package modules
+
+import (
+	"sort"
+	"strings"
+
+	"ghostlang.org/x/ghost/object"
+	"ghostlang.org/x/ghost/token"
+	"ghostlang.org/x/ghost/value"
+	"github.com/shopspring/decimal"
+)
+
+var ReflectMethods = map[string]*object.LibraryFunction{}
+var ReflectProperties = map[string]*object.LibraryProperty{}
+
+func init() {
+	RegisterMethod(ReflectMethods, "arity", reflectArity)
+	RegisterMethod(ReflectMethods, "call", reflectCall)
+	RegisterMethod(ReflectMethods, "classOf", reflectClassOf)
+	RegisterMethod(ReflectMethods, "hasMethod", reflectHasMethod)
+	RegisterMethod(ReflectMethods, "isInstanceOf", reflectIsInstanceOf)
+	RegisterMethod(ReflectMethods, "methods", reflectMethods)
+	RegisterMethod(ReflectMethods, "name", reflectName)
+	RegisterMethod(ReflectMethods, "parameters", reflectParameters)
+	RegisterMethod(ReflectMethods, "properties", reflectProperties)
+	RegisterMethod(ReflectMethods, "traits", reflectTraits)
+}
+
+// reflectArity returns the number of parameters a function declares, not
+// counting its rest parameter.
+func reflectArity(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return object.NewErrorAt(object.ArgumentError, tok, "reflect.arity() expects 1 argument. got=%d", len(args))
+	}
+
+	function, ok := args[0].(*object.Function)
+
+	if !ok {
+		return object.NewErrorAt(object.TypeError, tok, "reflect.arity() expects the first argument to be of type 'function'. got=%s", strings.ToLower(string(args[0].Type())))
+	}
+
+	return &object.Number{Value: decimal.NewFromInt(int64(len(function.Parameters)))}
+}
+
+// reflectCall calls the named method of an instance with the arguments
+// passed as a list.
+func reflectCall(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
+	if len(args) < 2 || len(args) > 3 {
+		return object.NewErrorAt(object.ArgumentError, tok, "reflect.call() expects 2 or 3 arguments. got=%d", len(args))
+	}
+
+	instance, ok := args[0].(*object.Instance)
+
+	if !ok {
+		return object.NewErrorAt(object.TypeError, tok, "reflect.call() expects the first argument to be of type 'instance'. got=%s", strings.ToLower(string(args[0].Type())))
+	}
+
+	name, ok := args[1].(*object.String)
+
+	if !ok {
+		return object.NewErrorAt(object.TypeError, tok, "reflect.call() expects the second argument to be of type 'string'. got=%s", strings.ToLower(string(args[1].Type())))
+	}
+
+	arguments := []object.Object{}
+
+	if len(args) == 3 {
+		list, ok := args[2].(*object.List)
+
+		if !ok {
+			return object.NewErrorAt(object.TypeError, tok, "reflect.call() expects the third argument to be of type 'list'. got=%s", strings.ToLower(string(args[2].Type())))
+		}
+
+		arguments = list.Elements
+	}
+
+	if method, _ := instance.Class.FindMethod(name.Value); method == nil {
+		return object.NewErrorAt(object.NameError, tok, "reflect.call() undefined method %s for class %s", name.Value, instance.Class.Name.Value)
+	}
+
+	// Like any other call, a method without a return statement returns null
+	switch result := instance.Call(name.Value, arguments, tok).(type) {
+	case *object.Error:
+		return result
+	case *object.Return:
+		return result.Value
+	}
+
+	return value.NULL
+}
+
+// reflectClassOf returns the class of an instance, or null for any other
+// value.
+func reflectClassOf(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return object.NewErrorAt(object.ArgumentError, tok, "reflect.classOf() expects 1 argument. got=%d", len(args))
+	}
+
+	if instance, ok := args[0].(*object.Instance); ok {
+		return instance.Class
+	}
+
+	return value.NULL
+}
+
+// reflectHasMethod checks if an instance or class responds to the named
+// method, including inherited and trait methods.
+func reflectHasMethod(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return object.NewErrorAt(object.ArgumentError, tok, "reflect.hasMethod() expects 2 arguments. got=%d", len(args))
+	}
+
+	class, ok := reflectedClass(args[0])
+
+	if !ok {
+		return object.NewErrorAt(object.TypeError, tok, "reflect.hasMethod() expects the first argument to be of type 'instance' or 'class'. got=%s", strings.ToLower(string(args[0].Type())))
+	}
+
+	name, ok := args[1].(*object.String)
+
+	if !ok {
+		return object.NewErrorAt(object.TypeError, tok, "reflect.hasMethod() expects the second argument to be of type 'string'. got=%s", strings.ToLower(string(args[1].Type())))
+	}
+
+	method, _ := class.FindMethod(name.Value)
+
+	return &object.Boolean{Value: method != nil}
+}
+
+// reflectIsInstanceOf checks if a value is an instance of the class or one of
+// its subclasses.
+func reflectIsInstanceOf(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return object.NewErrorAt(object.ArgumentError, tok, "reflect.isInstanceOf() expects 2 arguments. got=%d", len(args))
+	}
+
+	class, ok := args[1].(*object.Class)
+
+	if !ok {
+		return object.NewErrorAt(object.TypeError, tok, "reflect.isInstanceOf() expects the second argument to be of type 'class'. got=%s", strings.ToLower(string(args[1].Type())))
+	}
+
+	instance, ok := args[0].(*object.Instance)
+
+	if !ok {
+		return &object.Boolean{Value: false}
+	}
+
+	for current := instance.Class; current != nil; current = current.Super {
+		if current == class {
+			return &object.Boolean{Value: true}
+		}
+	}
+
+	return &object.Boolean{Value: false}
+}
+
+// reflectMethods returns the sorted names of the methods an instance or class
+// responds to, including inherited and trait methods.
+func reflectMethods(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return object.NewErrorAt(object.ArgumentError, tok, "reflect.methods() expects 1 argument. got=%d", len(args))
+	}
+
+	class, ok := reflectedClass(args[0])
+
+	if !ok {
+		return object.NewErrorAt(object.TypeError, tok, "reflect.methods() expects the first argument to be of type 'instance' or 'class'. got=%s", strings.ToLower(string(args[0].Type())))
+	}
+
+	names := map[string]bool{}
+
+	for current := class; current != nil; current = current.Super {
+		for name, value := range current.Environment.All() {
+			if _, ok := value.(*object.Function); ok {
+				names[name] = true
+			}
+		}
+
+		for _, trait := range current.Traits {
+			for name := range trait.Environment.All() {
+				if _, ok := trait.FindMethod(name); ok {
+					names[name] = true
+				}
+			}
+		}
+	}
+
+	return sortedNames(names)
+}
+
+// reflectName returns the name of a class, trait or enum.
+func reflectName(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return object.NewErrorAt(object.ArgumentError, tok, "reflect.name() expects 1 argument. got=%d", len(args))
+	}
+
+	switch named := args[0].(type) {
+	case *object.Class:
+		return &object.String{Value: named.Name.Value}
+	case *object.Trait:
+		return &object.String{Value: named.Name.Value}
+	case *object.Enum:
+		return &object.String{Value: named.Name}
+	}
+
+	return object.NewErrorAt(object.TypeError, tok, "reflect.name() expects the first argument to be of type 'class', 'trait' or 'enum'. got=%s", strings.ToLower(string(args[0].Type())))
+}
+
+// reflectParameters returns the parameter names of a function, with the rest
+// parameter, if any, prefixed by "...".
+func reflectParameters(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return object.NewErrorAt(object.ArgumentError, tok, "reflect.parameters() expects 1 argument. got=%d", len(args))
+	}
+
+	function, ok := args[0].(*object.Function)
+
+	if !ok {
+		return object.NewErrorAt(object.TypeError, tok, "reflect.parameters() expects the first argument to be of type 'function'. got=%s", strings.ToLower(string(args[0].Type())))
+	}
+
+	parameters := []object.Object{}
+
+	for _, parameter := range function.Parameters {
+		parameters = append(parameters, &object.String{Value: parameter.Value})
+	}
+
+	if function.Rest != nil {
+		parameters = append(parameters, &object.String{Value: "..." + function.Rest.Value})
+	}
+
+	return &object.List{Elements: parameters}
+}
+
+// reflectProperties returns the sorted names of the public properties of an
+// instance, or of the static members of a class.
+func reflectProperties(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return object.NewErrorAt(object.ArgumentError, tok, "reflect.properties() expects 1 argument. got=%d", len(args))
+	}
+
+	var store map[string]object.Object
+
+	switch reflected := args[0].(type) {
+	case *object.Instance:
+		store = reflected.Environment.All()
+	case *object.Class:
+		store = reflected.Static.All()
+	default:
+		return object.NewErrorAt(object.TypeError, tok, "reflect.properties() expects the first argument to be of type 'instance' or 'class'. got=%s", strings.ToLower(string(args[0].Type())))
+	}
+
+	names := map[string]bool{}
+
+	for name := range store {
+		if !strings.HasPrefix(name, "#") {
+			names[name] = true
+		}
+	}
+
+	return sortedNames(names)
+}
+
+// reflectTraits returns the traits used by an instance or class, including
+// the traits of its super classes.
+func reflectTraits(scope *object.Scope, tok token.Token, args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return object.NewErrorAt(object.ArgumentError, tok, "reflect.traits() expects 1 argument. got=%d", len(args))
+	}
+
+	class, ok := reflectedClass(args[0])
+
+	if !ok {
+		return object.NewErrorAt(object.TypeError, tok, "reflect.traits() expects the first argument to be of type 'instance' or 'class'. got=%s", strings.ToLower(string(args[0].Type())))
+	}
+
+	traits := []object.Object{}
+
+	for current := class; current != nil; current = current.Super {
+		for _, trait := range current.Traits {
+			traits = append(traits, trait)
+		}
+	}
+
+	return &object.List{Elements: traits}
+}
+
+// reflectedClass returns the class of an instance, or the class itself.
+func reflectedClass(reflected object.Object) (*object.Class, bool) {
+	switch reflected := reflected.(type) {
+	case *object.Instance:
+		return reflected.Class, true
+	case *object.Class:
+		return reflected, true
+	}
+
+	return nil, false
+}
+
+// sortedNames returns the names as a sorted list of strings.
+func sortedNames(names map[string]bool) *object.List {
+	sorted := make([]string, 0, len(names))
+
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+
+	sort.Strings(sorted)
+
+	elements := make([]object.Object, len(sorted))
+
+	for index, name := range sorted {
+		elements[index] = &object.String{Value: name}
+	}
+
+	return &object.List{Elements: elements}
+}
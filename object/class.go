@@ -6,11 +6,15 @@ import (
 
 const CLASS = "CLASS"
 
-// Class objects consist of a body and an environment.
+// Class objects consist of a body and an environment. Static members are kept
+// apart from the instance methods, and are accessed through the class itself.
 type Class struct {
 	Name        *ast.Identifier
 	Scope       *Scope
 	Environment *Environment
+	Static      *Environment
+	Getters     map[string]*Function
+	Setters     map[string]*Function
 	Super       *Class
 	Traits      []*Trait
 }
@@ -31,7 +35,7 @@ func (class *Class) Method(method string, args []Object) (Object, bool) {
 	case "new":
 		instance := &Instance{Class: class, Environment: NewEnclosedEnvironment(class.Environment)}
 
-		if ok := instance.Environment.Has("constructor"); ok {
+		if constructor, _ := class.FindMethod("constructor"); constructor != nil {
 			result := instance.Call("constructor", args, class.Name.Token)
 
 			if result != nil && result.Type() == ERROR {
@@ -40,7 +44,94 @@ func (class *Class) Method(method string, args []Object) (Object, bool) {
 		}
 
 		return instance, true
+	case "implements":
+		return implements(class, args), true
 	}
 
 	return nil, false
 }
+
+// implements checks if the class uses the trait passed as its only argument.
+func implements(class *Class, args []Object) Object {
+	if len(args) != 1 {
+		return NewMethodError(ArgumentError, "implements() expects 1 argument. got=%d", len(args))
+	}
+
+	trait, ok := args[0].(*Trait)
+
+	if !ok {
+		return NewMethodError(TypeError, "implements() expects a trait. got=%s", args[0].Type())
+	}
+
+	return &Boolean{Value: class.Implements(trait)}
+}
+
+// FindMethod returns the named method along with the class it was defined in,
+// checking the class itself, then its super classes, and then its traits.
+func (class *Class) FindMethod(name string) (Object, *Class) {
+	for current := class; current != nil; current = current.Super {
+		if method, ok := current.Environment.Get(name); ok {
+			return method, current
+		}
+	}
+
+	for current := class; current != nil; current = current.Super {
+		for _, trait := range current.Traits {
+			if method, ok := trait.FindMethod(name); ok {
+				return method, current
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// Implements reports whether the class, or one of its super classes, uses the
+// referenced trait.
+func (class *Class) Implements(trait *Trait) bool {
+	for current := class; current != nil; current = current.Super {
+		for _, used := range current.Traits {
+			if used == trait {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// FindStatic returns the named static member along with the class it was
+// declared in, checking the class itself and then its super classes.
+func (class *Class) FindStatic(name string) (Object, *Class) {
+	for current := class; current != nil; current = current.Super {
+		if member, ok := current.Static.Get(name); ok {
+			return member, current
+		}
+	}
+
+	return nil, nil
+}
+
+// FindGetter returns the named getter along with the class it was defined in,
+// checking the class itself and then its super classes.
+func (class *Class) FindGetter(name string) (*Function, *Class) {
+	for current := class; current != nil; current = current.Super {
+		if getter, ok := current.Getters[name]; ok {
+			return getter, current
+		}
+	}
+
+	return nil, nil
+}
+
+// FindSetter returns the named setter along with the class it was defined in,
+// checking the class itself and then its super classes.
+func (class *Class) FindSetter(name string) (*Function, *Class) {
+	for current := class; current != nil; current = current.Super {
+		if setter, ok := current.Setters[name]; ok {
+			return setter, current
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,69 @@
+package object
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+const ENUM = "ENUM"
+
+// enums counts the enums declared so far, giving each a unique id.
+var enums atomic.Uint64
+
+// Enum objects consist of a closed set of named members.
+type Enum struct {
+	Name    string
+	Members []*EnumMember // The members in declaration order
+
+	id uint64 // Distinguishes enums declared with the same name
+}
+
+// NewEnum creates an enum without any members yet. Each call creates a
+// distinct enum, even if another one of the same name exists.
+func NewEnum(name string) *Enum {
+	return &Enum{Name: name, id: enums.Add(1)}
+}
+
+// String represents the enum object's value as a string.
+func (enum *Enum) String() string {
+	return fmt.Sprintf("enum %s", enum.Name)
+}
+
+// Type returns the enum object type.
+func (enum *Enum) Type() Type {
+	return ENUM
+}
+
+// Method defines the set of methods available on enum objects.
+func (enum *Enum) Method(method string, args []Object) (Object, bool) {
+	switch method {
+	case "values":
+		return enum.values(args)
+	}
+
+	return nil, false
+}
+
+// Member returns the named member of the enum.
+func (enum *Enum) Member(name string) (*EnumMember, bool) {
+	for _, member := range enum.Members {
+		if member.Name == name {
+			return member, true
+		}
+	}
+
+	return nil, false
+}
+
+// =============================================================================
+// Object methods
+
+func (enum *Enum) values(args []Object) (Object, bool) {
+	elements := make([]Object, len(enum.Members))
+
+	for index, member := range enum.Members {
+		elements[index] = member
+	}
+
+	return &List{Elements: elements}, true
+}
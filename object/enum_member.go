@@ -0,0 +1,60 @@
+package object
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+const ENUM_MEMBER = "ENUM_MEMBER"
+
+// EnumMember objects consist of a member of an enum, along with its position
+// within the enum and its associated values.
+type EnumMember struct {
+	Enum   *Enum
+	Name   string
+	Value  int64 // The member's position within the enum, starting at 0
+	Values map[string]Object
+}
+
+// String represents the enum member's value as a string.
+func (member *EnumMember) String() string {
+	return member.Enum.Name + "." + member.Name
+}
+
+// Type returns the enum member object type.
+func (member *EnumMember) Type() Type {
+	return ENUM_MEMBER
+}
+
+// MapKey defines a unique hash value for use as a map key. Members are equal
+// only to themselves, so the key is made of the id of the member's enum and
+// the member's position within it.
+func (member *EnumMember) MapKey() MapKey {
+	return MapKey{Type: member.Type(), Value: member.Enum.id<<32 | uint64(member.Value)}
+}
+
+// Method defines the set of methods available on enum member objects.
+func (member *EnumMember) Method(method string, args []Object) (Object, bool) {
+	switch method {
+	case "toString":
+		return &String{Value: member.String()}, true
+	}
+
+	return nil, false
+}
+
+// Property defines the set of properties available on enum member objects.
+// Associated values take precedence over the name and value properties.
+func (member *EnumMember) Property(property string) (Object, bool) {
+	if value, ok := member.Values[property]; ok {
+		return value, true
+	}
+
+	switch property {
+	case "name":
+		return &String{Value: member.Name}, true
+	case "value":
+		return &Number{Value: decimal.NewFromInt(member.Value)}, true
+	}
+
+	return nil, false
+}
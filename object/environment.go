@@ -10,6 +10,9 @@ type Environment struct {
 	outer     *Environment
 	writer    io.Writer
 	directory string
+	block     bool
+	declared  map[string]bool
+	constants map[string]bool
 }
 
 func NewEnvironment() *Environment {
@@ -26,6 +29,14 @@ func NewEnclosedEnvironment(outer *Environment) *Environment {
 	return environment
 }
 
+// NewBlockEnvironment creates an environment for bindings scoped to a single
+// block, such as loop variables. Assigning a name that was not declared within
+// the block passes through to the outer environment. Most blocks declare
+// nothing, so the bindings are only allocated once one is declared.
+func NewBlockEnvironment(outer *Environment) *Environment {
+	return &Environment{outer: outer, writer: outer.writer, block: true}
+}
+
 func (environment *Environment) All() map[string]Object {
 	return environment.store
 }
@@ -51,11 +62,78 @@ func (environment *Environment) Get(name string) (Object, bool) {
 }
 
 func (environment *Environment) Set(name string, value Object) Object {
+	if _, ok := environment.store[name]; !ok && environment.block {
+		return environment.outer.Set(name, value)
+	}
+
+	environment.store[name] = value
+
+	return value
+}
+
+// Declare binds the value to name within this environment, even if it is a
+// block environment, and records that name was declared with let or const.
+func (environment *Environment) Declare(name string, value Object) Object {
+	environment.Bind(name, value)
+
+	if environment.declared == nil {
+		environment.declared = make(map[string]bool)
+	}
+
+	environment.declared[name] = true
+
+	return value
+}
+
+// DeclareConstant binds the value to name within this environment, and marks
+// the binding as one that cannot be reassigned.
+func (environment *Environment) DeclareConstant(name string, value Object) Object {
+	environment.Declare(name, value)
+
+	if environment.constants == nil {
+		environment.constants = make(map[string]bool)
+	}
+
+	environment.constants[name] = true
+
+	return value
+}
+
+// Bind binds the value to name within this environment, even if it is a block
+// environment, without counting as a declaration. Loop variables, caught
+// exceptions and matched patterns are bound this way, so a let or const
+// within their block may still shadow them.
+func (environment *Environment) Bind(name string, value Object) Object {
+	if environment.store == nil {
+		environment.store = make(map[string]Object)
+	}
+
 	environment.store[name] = value
 
+	delete(environment.constants, name)
+
 	return value
 }
 
+// IsDeclared determines if name was declared within this environment itself,
+// ignoring its outer environments.
+func (environment *Environment) IsDeclared(name string) bool {
+	return environment.declared[name]
+}
+
+// IsConstant determines if name resolves to a constant binding.
+func (environment *Environment) IsConstant(name string) bool {
+	if _, ok := environment.store[name]; ok {
+		return environment.constants[name]
+	}
+
+	if environment.outer != nil {
+		return environment.outer.IsConstant(name)
+	}
+
+	return false
+}
+
 func (environment *Environment) Delete(name string) {
 	delete(environment.store, name)
 }
@@ -2,13 +2,50 @@ package object
 
 import (
 	"fmt"
+	"strings"
+
+	"ghostlang.org/x/ghost/token"
 )
 
 const ERROR = "ERROR"
 
-// Error objects consist of a nil value.
+// Kinds of errors. Scripts read the kind of a caught error through its kind
+// property.
+const (
+	RuntimeError    = "RuntimeError"
+	TypeError       = "TypeError"
+	NameError       = "NameError"
+	ArgumentError   = "ArgumentError"
+	IndexError      = "IndexError"
+	ArithmeticError = "ArithmeticError"
+	IOError         = "IOError"
+	ImportError     = "ImportError"
+	SyntaxError     = "SyntaxError"
+)
+
+// Error objects consist of a message and the stack of calls the error
+// propagated through, innermost call first. Errors raised at a position in a
+// script also record where they occurred, with the message prefixed by the
+// position and the unprefixed message kept as the reason.
 type Error struct {
+	Kind    string
 	Message string
+	Reason  string
+	File    string
+	Line    int
+	Column  int
+	Cause   *Error // The error this error was raised in response to, if any
+	Data    Object // Additional data attached when the error was thrown
+	Stack   []Frame
+}
+
+// Frame describes a call an error propagated through: the function that was
+// called and where it was called from.
+type Frame struct {
+	Function string
+	File     string
+	Line     int
+	Column   int
 }
 
 // String represents the error object's value as a string.
@@ -21,6 +58,44 @@ func (err *Error) Type() Type {
 	return ERROR
 }
 
+// Error returns the error's message, so errors can be used as Go errors by
+// code embedding Ghost.
+func (err *Error) Error() string {
+	return err.Message
+}
+
+// Unwrap returns the error's cause, for use with errors.Is and errors.As.
+func (err *Error) Unwrap() error {
+	if err.Cause == nil {
+		return nil
+	}
+
+	return err.Cause
+}
+
+// Trace represents the error message followed by its stack trace.
+func (err *Error) Trace() string {
+	var out strings.Builder
+
+	out.WriteString(err.Message)
+
+	for _, frame := range err.Stack {
+		out.WriteString(fmt.Sprintf("\n    in %s, called from %s:%d:%d", frame.Function, frame.File, frame.Line, frame.Column))
+	}
+
+	if err.Cause != nil {
+		out.WriteString("\ncaused by: " + err.Cause.Trace())
+	}
+
+	return out.String()
+}
+
+// AddFrame records that the error propagated out of the named function, which
+// was called at the referenced token.
+func (err *Error) AddFrame(function string, tok token.Token) {
+	err.Stack = append(err.Stack, Frame{Function: function, File: tok.File, Line: tok.Line, Column: tok.Column})
+}
+
 // Method defines the set of methods available on error objects.
 func (err *Error) Method(method string, args []Object) (Object, bool) {
 	return nil, false
@@ -35,6 +110,48 @@ func IsError(obj Object) bool {
 	return false
 }
 
+// NewError creates a runtime error with the referenced message.
 func NewError(format string, a ...interface{}) *Error {
-	return &Error{Message: fmt.Sprintf(format, a...)}
+	message := fmt.Sprintf(format, a...)
+
+	return &Error{Kind: RuntimeError, Message: message, Reason: message}
+}
+
+// NewMethodError creates an error of the referenced kind raised by a built-in
+// method. Methods don't know where they were called from, so the caller
+// records the position of the call through Locate.
+func NewMethodError(kind string, format string, a ...interface{}) *Error {
+	reason := fmt.Sprintf(format, a...)
+
+	return &Error{Kind: kind, Message: reason, Reason: reason}
+}
+
+// Locate records the position of the token as where the error was raised,
+// unless the error already has a position.
+func (err *Error) Locate(tok token.Token) {
+	if err.Line > 0 {
+		return
+	}
+
+	located := NewErrorAt(err.Kind, tok, "%s", err.Reason)
+
+	err.Message = located.Message
+	err.File = located.File
+	err.Line = located.Line
+	err.Column = located.Column
+}
+
+// NewErrorAt creates an error of the referenced kind, raised at the position
+// of the token.
+func NewErrorAt(kind string, tok token.Token, format string, a ...interface{}) *Error {
+	reason := fmt.Sprintf(format, a...)
+
+	return &Error{
+		Kind:    kind,
+		Message: fmt.Sprintf("%d:%d:%s: runtime error: %s", tok.Line, tok.Column, tok.File, reason),
+		Reason:  reason,
+		File:    tok.File,
+		Line:    tok.Line,
+		Column:  tok.Column,
+	}
 }
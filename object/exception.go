@@ -0,0 +1,71 @@
+package object
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+const EXCEPTION = "EXCEPTION"
+
+// Exception objects consist of a runtime error that has been caught. Unlike
+// errors, exceptions are regular values that can be passed around and
+// inspected without aborting the program.
+type Exception struct {
+	Error *Error
+}
+
+// NewException creates a new exception object from the referenced error.
+func NewException(err *Error) *Exception {
+	return &Exception{Error: err}
+}
+
+// String represents the exception object's value as a string.
+func (exception *Exception) String() string {
+	return exception.Error.String()
+}
+
+// Type returns the exception object type.
+func (exception *Exception) Type() Type {
+	return EXCEPTION
+}
+
+// Method defines the set of methods available on exception objects.
+func (exception *Exception) Method(method string, args []Object) (Object, bool) {
+	switch method {
+	case "toString":
+		return &String{Value: exception.String()}, true
+	}
+
+	return nil, false
+}
+
+// Property defines the set of properties available on exception objects.
+func (exception *Exception) Property(property string) (Object, bool) {
+	err := exception.Error
+
+	switch property {
+	case "kind":
+		return &String{Value: err.Kind}, true
+	case "message":
+		return &String{Value: err.Reason}, true
+	case "file":
+		return &String{Value: err.File}, true
+	case "line":
+		return &Number{Value: decimal.NewFromInt(int64(err.Line))}, true
+	case "column":
+		return &Number{Value: decimal.NewFromInt(int64(err.Column))}, true
+	case "cause":
+		if err.Cause == nil {
+			return &Null{}, true
+		}
+
+		return NewException(err.Cause), true
+	case "data":
+		if err.Data == nil {
+			return &Null{}, true
+		}
+
+		return err.Data, true
+	}
+
+	return nil, false
+}
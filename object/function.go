@@ -8,12 +8,19 @@ import (
 
 const FUNCTION = "FUNCTION"
 
-// Function objects consist of a user-generated function.
+// Function objects consist of a user-generated function. Functions capture
+// the environment they were defined in by reference, so they observe later
+// changes made to the variables of that environment. Assigning to a name
+// within a function always binds a variable local to that call.
 type Function struct {
+	Name       string // Empty for anonymous functions
 	Parameters []*ast.Identifier
 	Body       *ast.Block
 	Defaults   map[string]ast.ExpressionNode
+	Rest       *ast.Identifier // Collects any arguments beyond Parameters
 	Scope      *Scope
+	Arrow      bool // Arrow functions keep "this" from where they were defined
+	Generator  bool // Calling a generator function returns a generator
 }
 
 // String represents the function object's value as a string.
@@ -26,12 +33,22 @@ func (function *Function) Type() Type {
 	return FUNCTION
 }
 
+// Describe returns the function's name for stack traces.
+func (function *Function) Describe() string {
+	if function.Name == "" {
+		return "anonymous function"
+	}
+
+	return function.Name
+}
+
 // Method defines the set of methods available on function objects.
 func (function *Function) Method(method string, args []Object) (Object, bool) {
 	return nil, false
 }
 
 // Evaluate evaluates the function's body ast.Block and returns the result.
+// Generator functions return a generator instead, wrapped as a return value.
 func (function *Function) Evaluate(args []Object, writer io.Writer) Object {
 	scope := function.scope(args)
 
@@ -39,11 +56,27 @@ func (function *Function) Evaluate(args []Object, writer io.Writer) Object {
 		scope.Environment.SetWriter(writer)
 	}
 
+	if function.Generator {
+		return &Return{Value: NewGenerator(function.Body, scope)}
+	}
+
 	result := evaluator(function.Body, scope)
 
 	return result
 }
 
+// RestArguments returns the arguments beyond the function's parameters as a
+// list, to be bound to its rest parameter.
+func (function *Function) RestArguments(arguments []Object) *List {
+	rest := []Object{}
+
+	if len(arguments) > len(function.Parameters) {
+		rest = append(rest, arguments[len(function.Parameters):]...)
+	}
+
+	return &List{Elements: rest}
+}
+
 // =============================================================================
 // Helper methods
 
@@ -53,15 +86,24 @@ func (function *Function) scope(arguments []Object) *Scope {
 		Environment: NewEnclosedEnvironment(function.Scope.Environment),
 	}
 
+	if function.Arrow {
+		scope.Self = function.Scope.Self
+		scope.Class = function.Scope.Class
+	}
+
 	for key, val := range function.Defaults {
 		scope.Environment.Set(key, evaluator(val, scope))
 	}
 
 	for index, parameter := range function.Parameters {
-		if index < len(arguments) {
+		if index < len(arguments) && arguments[index] != nil {
 			scope.Environment.Set(parameter.Value, arguments[index])
 		}
 	}
 
+	if function.Rest != nil {
+		scope.Environment.Set(function.Rest.Value, function.RestArguments(arguments))
+	}
+
 	return scope
 }
@@ -0,0 +1,202 @@
+package object
+
+import (
+	"runtime"
+
+	"ghostlang.org/x/ghost/ast"
+)
+
+const GENERATOR = "GENERATOR"
+
+// Generator objects consist of the suspended body of a generator function.
+// The body runs on its own goroutine, taking turns with the caller: each time
+// a value is requested, the body runs until its next yield expression.
+//
+// The goroutine only holds on to the generator's coroutine, never to the
+// generator itself. A generator dropped before its body completed can still
+// be collected, at which point its goroutine is ended, unless its own body can
+// reach it, such as through a property of the instance it was called on.
+type Generator struct {
+	coroutine *Coroutine
+}
+
+// Coroutine is the body of a generator, evaluated on its own goroutine.
+type Coroutine struct {
+	Body  *ast.Block
+	Scope *Scope
+
+	started bool
+	running bool // The body is being evaluated, rather than suspended
+	done    bool
+	resume  chan resumption
+	yields  chan Object
+	result  chan Object
+}
+
+// resumption tells a suspended body how to carry on.
+type resumption int
+
+const (
+	// resumed continues the body until its next yield.
+	resumed resumption = iota
+
+	// closed unwinds the body as if it had returned, running its finally
+	// blocks.
+	closed
+
+	// abandoned ends the goroutine of a generator that was collected,
+	// without evaluating any more of its body.
+	abandoned
+)
+
+// NewGenerator creates a generator that evaluates body within scope once its
+// first value is requested.
+func NewGenerator(body *ast.Block, scope *Scope) *Generator {
+	coroutine := &Coroutine{
+		Body:   body,
+		Scope:  scope,
+		resume: make(chan resumption),
+		yields: make(chan Object),
+		result: make(chan Object),
+	}
+
+	scope.Coroutine = coroutine
+
+	generator := &Generator{coroutine: coroutine}
+
+	runtime.SetFinalizer(generator, (*Generator).abandon)
+
+	return generator
+}
+
+// String represents the generator object's value as a string.
+func (generator *Generator) String() string {
+	return "generator"
+}
+
+// Type returns the generator object type.
+func (generator *Generator) Type() Type {
+	return GENERATOR
+}
+
+// Method defines the set of methods available on generator objects.
+func (generator *Generator) Method(method string, args []Object) (Object, bool) {
+	// A generator can't close itself from within its own body
+	if method == "close" && generator.coroutine.running {
+		return NewMethodError(TypeError, "generator is already running"), true
+	}
+
+	return iteratorMethod(generator, method, args)
+}
+
+// Iterator returns the generator itself, as generators can only be consumed
+// once.
+func (generator *Generator) Iterator() Iterator {
+	return generator
+}
+
+// Next resumes the body until it yields its next value, and reports true once
+// the body has completed. Resuming a generator from within its own body is an
+// error, as the body can't be suspended and evaluated at the same time.
+func (generator *Generator) Next() (Object, bool) {
+	coroutine := generator.coroutine
+
+	if coroutine.running {
+		return NewMethodError(TypeError, "generator is already running"), true
+	}
+
+	if coroutine.done {
+		return nil, true
+	}
+
+	coroutine.running = true
+
+	defer func() { coroutine.running = false }()
+
+	if !coroutine.started {
+		coroutine.started = true
+
+		go coroutine.run()
+	} else {
+		coroutine.resume <- resumed
+	}
+
+	select {
+	case yielded := <-coroutine.yields:
+		return yielded, false
+	case result := <-coroutine.result:
+		coroutine.done = true
+
+		if IsError(result) {
+			return result, true
+		}
+
+		return nil, true
+	}
+}
+
+// Close unwinds the body of a generator that was not consumed to the end.
+func (generator *Generator) Close() {
+	coroutine := generator.coroutine
+
+	// The body is evaluating the very call closing it, so it can't unwind
+	if coroutine.running {
+		return
+	}
+
+	if !coroutine.started || coroutine.done {
+		coroutine.done = true
+
+		return
+	}
+
+	coroutine.done = true
+	coroutine.running = true
+
+	defer func() { coroutine.running = false }()
+
+	coroutine.resume <- closed
+
+	for {
+		select {
+		case <-coroutine.yields:
+			coroutine.resume <- closed
+		case <-coroutine.result:
+			return
+		}
+	}
+}
+
+// abandon ends the goroutine of a generator that is being collected. As
+// nothing can resume the generator anymore, its body is suspended at a yield.
+func (generator *Generator) abandon() {
+	coroutine := generator.coroutine
+
+	if coroutine.started && !coroutine.done {
+		coroutine.done = true
+		coroutine.resume <- abandoned
+	}
+}
+
+// Yield hands the value to the caller of Next, and suspends the body until
+// the next value is requested. It returns false if the generator was closed
+// instead, in which case the body should unwind without running further. If
+// the generator was collected, Yield does not return at all.
+func (coroutine *Coroutine) Yield(value Object) bool {
+	coroutine.yields <- value
+
+	switch <-coroutine.resume {
+	case resumed:
+		return true
+	case closed:
+		return false
+	}
+
+	runtime.Goexit()
+
+	return false
+}
+
+func (coroutine *Coroutine) run() {
+	coroutine.result <- evaluator(coroutine.Body, coroutine.Scope)
+}
@@ -8,14 +8,31 @@ import (
 
 const INSTANCE = "INSTANCE"
 
-// Instance objects consist of a body and an environment.
+// Instance objects consist of a body and an environment. Private properties,
+// whose names start with "#", are kept apart for each class whose methods
+// assigned them, so a subclass never sees those of the class it extends.
 type Instance struct {
 	Class       *Class
 	Environment *Environment
+
+	private map[*Class]map[string]Object
 }
 
-// String represents the instance object's value as a string.
+// String represents the instance object's value as a string. Classes may
+// define a toString() method to customize it.
 func (instance *Instance) String() string {
+	if method, _ := instance.Class.FindMethod("toString"); method != nil {
+		result := instance.Call("toString", []Object{}, instance.Class.Name.Token)
+
+		if returned, ok := result.(*Return); ok {
+			result = returned.Value
+		}
+
+		if str, ok := result.(*String); ok {
+			return str.Value
+		}
+	}
+
 	return fmt.Sprintf("class instance %s", instance.Class.Name.Value)
 }
 
@@ -26,20 +43,57 @@ func (instance *Instance) Type() Type {
 
 // Method defines the set of methods available on instance objects.
 func (instance *Instance) Method(method string, args []Object) (Object, bool) {
+	switch method {
+	case "implements":
+		return implements(instance.Class, args), true
+	}
+
 	return nil, false
 }
 
+// PrivateProperty returns the private property name that the methods of
+// class assigned to the instance.
+func (instance *Instance) PrivateProperty(class *Class, name string) (Object, bool) {
+	value, ok := instance.private[class][name]
+
+	return value, ok
+}
+
+// SetPrivateProperty assigns the private property name of the instance on
+// behalf of the methods of class.
+func (instance *Instance) SetPrivateProperty(class *Class, name string, value Object) {
+	if instance.private == nil {
+		instance.private = map[*Class]map[string]Object{}
+	}
+
+	if instance.private[class] == nil {
+		instance.private[class] = map[string]Object{}
+	}
+
+	instance.private[class][name] = value
+}
+
 func (instance *Instance) Call(name string, arguments []Object, tok token.Token) Object {
-	if function, ok := instance.Environment.Get(name); ok {
+	if function, definedIn := instance.Class.FindMethod(name); function != nil {
 		if method, ok := function.(*Function); ok {
 			methodEnvironment := createMethodEnvironment(method, arguments)
-			methodScope := &Scope{Self: instance, Environment: methodEnvironment}
+			methodScope := &Scope{Self: instance, Environment: methodEnvironment, Class: definedIn}
 
-			return evaluator(method.Body, methodScope)
+			if method.Generator {
+				return &Return{Value: NewGenerator(method.Body, methodScope)}
+			}
+
+			result := evaluator(method.Body, methodScope)
+
+			if err, ok := result.(*Error); ok {
+				err.AddFrame(definedIn.Name.Value+"."+name, tok)
+			}
+
+			return result
 		}
 	}
 
-	return NewError("%d:%d: runtime error: unknown method '%s' on class %s", tok.Line, tok.Column, name, instance.Class.Name.Value)
+	return NewErrorAt(NameError, tok, "unknown method '%s' on class %s", name, instance.Class.Name.Value)
 }
 
 func createMethodEnvironment(method *Function, arguments []Object) *Environment {
@@ -50,10 +104,14 @@ func createMethodEnvironment(method *Function, arguments []Object) *Environment
 	}
 
 	for index, parameter := range method.Parameters {
-		if index < len(arguments) {
+		if index < len(arguments) && arguments[index] != nil {
 			env.Set(parameter.Value, arguments[index])
 		}
 	}
 
+	if method.Rest != nil {
+		env.Set(method.Rest.Value, method.RestArguments(arguments))
+	}
+
 	return env
 }
@@ -0,0 +1,69 @@
+package object
+
+// Iterable is implemented by objects that can be consumed lazily by a for in
+// loop, one element at a time.
+type Iterable interface {
+	Iterator() Iterator
+}
+
+// Iterator produces the elements of an iterable one at a time.
+type Iterator interface {
+	// Next returns the next element, and true once there are no elements
+	// left. If producing the element failed, the error is returned along
+	// with true.
+	Next() (Object, bool)
+
+	// Close releases an iterator that was not consumed to the end.
+	Close()
+}
+
+// iteratorMethod defines the set of methods available on iterator objects.
+// The next method follows the same protocol as class instances implementing
+// next(), returning a map with "value" and "done" keys. The close method
+// releases an iterator that will not be consumed to the end.
+func iteratorMethod(iterator Iterator, method string, args []Object) (Object, bool) {
+	switch method {
+	case "close":
+		iterator.Close()
+
+		return &Null{}, true
+	case "next":
+		element, done := iterator.Next()
+
+		if IsError(element) {
+			return element, true
+		}
+
+		if element == nil {
+			element = &Null{}
+		}
+
+		valueKey := &String{Value: "value"}
+		doneKey := &String{Value: "done"}
+
+		pairs := map[MapKey]MapPair{
+			valueKey.MapKey(): {Key: valueKey, Value: element},
+			doneKey.MapKey():  {Key: doneKey, Value: &Boolean{Value: done}},
+		}
+
+		return &Map{Pairs: pairs}, true
+	case "toList":
+		elements := []Object{}
+
+		for {
+			element, done := iterator.Next()
+
+			if IsError(element) {
+				return element, true
+			}
+
+			if done {
+				return &List{Elements: elements}, true
+			}
+
+			elements = append(elements, element)
+		}
+	}
+
+	return nil, false
+}
@@ -4,10 +4,12 @@ import "fmt"
 
 const LIBRARY_FUNCTION = "LIBRARY_FUNCTION"
 
-// LibraryFunction objects consist of a native Go function.
+// LibraryFunction objects consist of a native Go function. Functions that
+// accept named arguments receive them as a trailing map argument.
 type LibraryFunction struct {
-	Name     string
-	Function GoFunction
+	Name           string
+	Function       GoFunction
+	NamedArguments bool
 }
 
 // String represents the library function's value as a string.
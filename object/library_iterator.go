@@ -0,0 +1,53 @@
+package object
+
+import "fmt"
+
+const LIBRARY_ITERATOR = "LIBRARY_ITERATOR"
+
+// LibraryIterator objects consist of native Go functions producing elements
+// one at a time, such as the lines of a file.
+type LibraryIterator struct {
+	Name     string
+	Function func() (Object, bool)
+	Release  func()
+}
+
+// String represents the library iterator's value as a string.
+func (libraryIterator *LibraryIterator) String() string {
+	return fmt.Sprintf("library iterator {%s}", libraryIterator.Name)
+}
+
+// Type returns the library iterator object type.
+func (libraryIterator *LibraryIterator) Type() Type {
+	return LIBRARY_ITERATOR
+}
+
+// Method defines the set of methods available on library iterator objects.
+func (libraryIterator *LibraryIterator) Method(method string, args []Object) (Object, bool) {
+	return iteratorMethod(libraryIterator, method, args)
+}
+
+// Iterator returns the library iterator itself.
+func (libraryIterator *LibraryIterator) Iterator() Iterator {
+	return libraryIterator
+}
+
+// Next returns the next element produced by the native function.
+func (libraryIterator *LibraryIterator) Next() (Object, bool) {
+	element, done := libraryIterator.Function()
+
+	if done && libraryIterator.Release != nil {
+		libraryIterator.Release()
+		libraryIterator.Release = nil
+	}
+
+	return element, done
+}
+
+// Close releases the resources held by the native function, if any.
+func (libraryIterator *LibraryIterator) Close() {
+	if libraryIterator.Release != nil {
+		libraryIterator.Release()
+		libraryIterator.Release = nil
+	}
+}
@@ -0,0 +1,186 @@
+package object
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+const RANGE = "RANGE"
+
+// Range objects consist of an inclusive sequence of numbers from start to
+// end. Ranges are not materialized: their elements are calculated only when
+// they are indexed or iterated over.
+type Range struct {
+	Start decimal.Decimal
+	End   decimal.Decimal
+	Step  decimal.Decimal // Negative for descending ranges
+}
+
+// NewRange creates a range from start to end, counting by the referenced
+// positive step. Ranges whose start is greater than their end descend.
+func NewRange(start decimal.Decimal, end decimal.Decimal, step decimal.Decimal) *Range {
+	if start.GreaterThan(end) {
+		step = step.Neg()
+	}
+
+	return &Range{Start: start, End: end, Step: step}
+}
+
+// String represents the range object's value as a string.
+func (rangeObject *Range) String() string {
+	if rangeObject.Step.Abs().Equal(decimal.NewFromInt(1)) {
+		return fmt.Sprintf("%s..%s", rangeObject.Start, rangeObject.End)
+	}
+
+	return fmt.Sprintf("%s..%s step %s", rangeObject.Start, rangeObject.End, rangeObject.Step.Abs())
+}
+
+// Type returns the range object type.
+func (rangeObject *Range) Type() Type {
+	return RANGE
+}
+
+// Method defines the set of methods available on range objects.
+func (rangeObject *Range) Method(method string, args []Object) (Object, bool) {
+	switch method {
+	case "contains":
+		return rangeObject.contains(args)
+	case "first":
+		return rangeObject.first(args)
+	case "last":
+		return rangeObject.last(args)
+	case "length":
+		return rangeObject.length(args)
+	case "toList":
+		return rangeObject.toList(args)
+	case "toString":
+		return rangeObject.toString(args)
+	}
+
+	return nil, false
+}
+
+// Property defines the set of properties available on range objects.
+func (rangeObject *Range) Property(property string) (Object, bool) {
+	switch property {
+	case "start":
+		return &Number{Value: rangeObject.Start}, true
+	case "end":
+		return &Number{Value: rangeObject.End}, true
+	case "step":
+		return &Number{Value: rangeObject.Step.Abs()}, true
+	}
+
+	return nil, false
+}
+
+// Iterator returns an iterator over the elements of the range.
+func (rangeObject *Range) Iterator() Iterator {
+	return &rangeIterator{rangeObject: rangeObject, length: rangeObject.Length()}
+}
+
+// Length returns the number of elements in the range.
+func (rangeObject *Range) Length() int64 {
+	length := rangeObject.End.Sub(rangeObject.Start).Div(rangeObject.Step).Floor().IntPart() + 1
+
+	if length < 0 {
+		return 0
+	}
+
+	return length
+}
+
+// Contains determines if the referenced number is an element of the range.
+func (rangeObject *Range) Contains(number decimal.Decimal) bool {
+	offset := number.Sub(rangeObject.Start).Div(rangeObject.Step)
+
+	if !offset.IsInteger() || offset.IsNegative() {
+		return false
+	}
+
+	return offset.IntPart() < rangeObject.Length()
+}
+
+// At returns the element at the referenced index, and false if the index is
+// out of bounds.
+func (rangeObject *Range) At(index int64) (*Number, bool) {
+	if index < 0 || index >= rangeObject.Length() {
+		return nil, false
+	}
+
+	return rangeObject.element(index), true
+}
+
+func (rangeObject *Range) element(index int64) *Number {
+	return &Number{Value: rangeObject.Start.Add(rangeObject.Step.Mul(decimal.NewFromInt(index)))}
+}
+
+// =============================================================================
+// Object methods
+
+func (rangeObject *Range) contains(args []Object) (Object, bool) {
+	if len(args) != 1 {
+		return NewMethodError(ArgumentError, "range.contains() expects 1 argument. got=%d", len(args)), true
+	}
+
+	number, ok := args[0].(*Number)
+
+	return &Boolean{Value: ok && rangeObject.Contains(number.Value)}, true
+}
+
+func (rangeObject *Range) first(args []Object) (Object, bool) {
+	if element, ok := rangeObject.At(0); ok {
+		return element, true
+	}
+
+	return &Null{}, true
+}
+
+func (rangeObject *Range) last(args []Object) (Object, bool) {
+	if element, ok := rangeObject.At(rangeObject.Length() - 1); ok {
+		return element, true
+	}
+
+	return &Null{}, true
+}
+
+func (rangeObject *Range) length(args []Object) (Object, bool) {
+	return &Number{Value: decimal.NewFromInt(rangeObject.Length())}, true
+}
+
+func (rangeObject *Range) toList(args []Object) (Object, bool) {
+	elements := make([]Object, rangeObject.Length())
+
+	for index := range elements {
+		elements[index] = rangeObject.element(int64(index))
+	}
+
+	return &List{Elements: elements}, true
+}
+
+func (rangeObject *Range) toString(args []Object) (Object, bool) {
+	return &String{Value: rangeObject.String()}, true
+}
+
+// rangeIterator produces the elements of a range one at a time.
+type rangeIterator struct {
+	rangeObject *Range
+	length      int64
+	index       int64
+}
+
+// Next returns the next element of the range.
+func (iterator *rangeIterator) Next() (Object, bool) {
+	if iterator.index >= iterator.length {
+		return nil, true
+	}
+
+	element := iterator.rangeObject.element(iterator.index)
+	iterator.index++
+
+	return element, false
+}
+
+// Close does nothing, as ranges hold no resources.
+func (iterator *rangeIterator) Close() {}
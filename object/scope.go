@@ -6,6 +6,8 @@ const SCOPE = "SCOPE"
 type Scope struct {
 	Environment *Environment
 	Self        Object
+	Class       *Class     // The class the evaluated method was defined in
+	Coroutine   *Coroutine // The body of the generator being evaluated
 }
 
 // String represents the scope object's value as a string.
@@ -129,7 +129,7 @@ func (str *String) matches(args []Object) (Object, bool) {
 	matches, err := regexp.Match(str.Value, []byte(args[0].(*String).Value))
 
 	if err != nil {
-		return &Error{Message: err.Error()}, false
+		return NewMethodError(ArgumentError, "string.matches() expects a valid regular expression: %s", err), true
 	}
 
 	return &Boolean{Value: matches}, true
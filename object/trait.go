@@ -4,11 +4,14 @@ import "ghostlang.org/x/ghost/ast"
 
 const TRAIT = "TRAIT"
 
-// Trait objects consist of a body and an environment.
+// Trait objects consist of a body and an environment. Abstract methods are
+// declared without a body and must be implemented by the classes using the
+// trait.
 type Trait struct {
 	Name        *ast.Identifier
 	Scope       *Scope
 	Environment *Environment
+	Abstract    []*ast.Abstract
 }
 
 // String represents the class object's value as a string.
@@ -25,3 +28,10 @@ func (trait *Trait) Type() Type {
 func (trait *Trait) Method(method string, args []Object) (Object, bool) {
 	return nil, false
 }
+
+// FindMethod returns the named method defined in the trait body.
+func (trait *Trait) FindMethod(name string) (*Function, bool) {
+	method, ok := trait.Environment.All()[name].(*Function)
+
+	return method, ok
+}
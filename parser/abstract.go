@@ -0,0 +1,38 @@
+package parser
+
+import (
+	"fmt"
+
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/token"
+)
+
+// abstractStatement parses a method that a trait requires the classes using
+// it to implement (abstract function area()). Abstract methods have no body,
+// and "abstract" is contextual, so it remains usable as an identifier.
+func (parser *Parser) abstractStatement() ast.StatementNode {
+	abstract := &ast.Abstract{Token: parser.currentToken}
+
+	parser.readToken()
+
+	if !parser.expectNextTokenIs(token.IDENTIFIER) {
+		return nil
+	}
+
+	abstract.Name = &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
+
+	if !parser.expectNextTokenIs(token.LEFTPAREN) {
+		return nil
+	}
+
+	_, abstract.Parameters, _ = parser.functionParameters()
+	abstract.Closing = parser.currentToken
+
+	if parser.nextTokenIs(token.LEFTBRACE) {
+		parser.syntaxError(abstract.Token, fmt.Sprintf("abstract method `%s` must not have a body", abstract.Name.Value))
+
+		return nil
+	}
+
+	return abstract
+}
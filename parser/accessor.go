@@ -0,0 +1,36 @@
+package parser
+
+import (
+	"fmt"
+
+	"ghostlang.org/x/ghost/ast"
+)
+
+// accessorStatement parses a computed property of a class, either a getter
+// (get name() { }) or a setter (set name(value) { }). The "get" and "set"
+// keywords are contextual, so both remain usable as identifiers.
+func (parser *Parser) accessorStatement() ast.StatementNode {
+	accessor := &ast.Accessor{Token: parser.currentToken, Setter: parser.currentToken.Lexeme == "set"}
+
+	function, ok := parser.functionStatement().(*ast.Function)
+
+	if !ok {
+		return nil
+	}
+
+	if accessor.Setter && (function.Rest != nil || len(function.Parameters) != 1) {
+		parser.syntaxError(accessor.Token, fmt.Sprintf("setter `%s` must have exactly one parameter", function.Name.Value))
+
+		return nil
+	}
+
+	if !accessor.Setter && (function.Rest != nil || len(function.Parameters) != 0) {
+		parser.syntaxError(accessor.Token, fmt.Sprintf("getter `%s` must not have parameters", function.Name.Value))
+
+		return nil
+	}
+
+	accessor.Function = function
+
+	return accessor
+}
@@ -0,0 +1,103 @@
+package parser
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/token"
+)
+
+// arrowFunction parses the remaining parameters of a parenthesized arrow
+// function, starting from the already parsed first parameter, followed by the
+// arrow and its body.
+func (parser *Parser) arrowFunction(opening token.Token, parameter ast.ExpressionNode) ast.ExpressionNode {
+	function := &ast.Function{Opening: opening, Defaults: make(map[string]ast.ExpressionNode), Parameters: []*ast.Identifier{}, Arrow: true}
+
+	for parameter != nil {
+		if spread, ok := parameter.(*ast.Spread); ok {
+			function.Rest = parser.arrowParameter(spread.Value)
+
+			if function.Rest == nil {
+				return nil
+			}
+
+			break
+		}
+
+		identifier := parser.arrowParameter(parameter)
+
+		if identifier == nil {
+			return nil
+		}
+
+		function.Parameters = append(function.Parameters, identifier)
+
+		if parser.nextTokenIs(token.EQUAL) {
+			parser.readToken()
+			parser.readToken()
+
+			function.Defaults[identifier.Value] = parser.parseExpression(LOWEST)
+		}
+
+		parameter = nil
+
+		if parser.nextTokenIs(token.COMMA) {
+			parser.readToken()
+			parser.readToken()
+
+			parameter = parser.parseExpression(LOWEST)
+		}
+	}
+
+	if !parser.expectNextTokenIs(token.RIGHTPAREN) {
+		return nil
+	}
+
+	if !parser.expectNextTokenIs(token.ARROW) {
+		return nil
+	}
+
+	return parser.arrowBody(function)
+}
+
+// arrowBody parses the body of an arrow function. The body is either a block,
+// or a single expression whose value is returned.
+func (parser *Parser) arrowBody(function *ast.Function) ast.ExpressionNode {
+	function.Token = parser.currentToken
+	function.Arrow = true
+
+	if parser.nextTokenIs(token.LEFTBRACE) {
+		parser.readToken()
+		parser.enterFunctionBody()
+
+		function.Body = parser.blockStatement()
+		function.Generator = parser.leaveFunctionBody()
+
+		return function
+	}
+
+	parser.readToken()
+	parser.enterFunctionBody()
+
+	function.Body = &ast.Block{
+		Token: function.Token,
+		Statements: []ast.StatementNode{
+			&ast.Return{Token: function.Token, Value: parser.parseExpression(LOWEST)},
+		},
+	}
+	function.Generator = parser.leaveFunctionBody()
+
+	return function
+}
+
+// arrowParameter ensures the parsed parameter of an arrow function is an
+// identifier.
+func (parser *Parser) arrowParameter(parameter ast.ExpressionNode) *ast.Identifier {
+	identifier, ok := parameter.(*ast.Identifier)
+
+	if !ok {
+		parser.syntaxError(parser.currentToken, "expected arrow function parameter to be an identifier")
+
+		return nil
+	}
+
+	return identifier
+}
@@ -1,6 +1,8 @@
 package parser
 
 import (
+	"fmt"
+
 	"ghostlang.org/x/ghost/ast"
 	"ghostlang.org/x/ghost/token"
 )
@@ -12,12 +14,18 @@ func (parser *Parser) blockStatement() *ast.Block {
 	parser.readToken()
 
 	for !parser.currentTokenIs(token.RIGHTBRACE) && !parser.isAtEnd() {
-		statement := parser.statement()
+		statement := parser.recoverableStatement()
 
 		block.Statements = append(block.Statements, statement)
 
 		parser.readToken()
 	}
 
+	block.Closing = parser.currentToken
+
+	if parser.isAtEnd() {
+		parser.syntaxError(parser.currentToken, fmt.Sprintf("expected `}` to close the block opened at %d:%d, got end of file", block.Token.Line, block.Token.Column))
+	}
+
 	return block
 }
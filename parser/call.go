@@ -1,6 +1,8 @@
 package parser
 
 import (
+	"fmt"
+
 	"ghostlang.org/x/ghost/ast"
 	"ghostlang.org/x/ghost/token"
 )
@@ -8,7 +10,77 @@ import (
 func (parser *Parser) callExpression(callee ast.ExpressionNode) ast.ExpressionNode {
 	call := &ast.Call{Token: parser.currentToken, Callee: callee}
 
-	call.Arguments = parser.parseExpressionList(token.RIGHTPAREN)
+	call.Arguments, call.Named = parser.callArguments()
+	call.Closing = parser.currentToken
+
+	if parser.isMatchExpression(call) {
+		return parser.matchExpression(callee.(*ast.Identifier).Token, call.Arguments[0])
+	}
 
 	return call
 }
+
+// isMatchExpression determines if a call is the start of a match expression,
+// which passes a single value to match and is followed by a block of cases on
+// the same line.
+func (parser *Parser) isMatchExpression(call *ast.Call) bool {
+	identifier, ok := call.Callee.(*ast.Identifier)
+
+	if !ok || identifier.Value != "match" || len(call.Arguments) != 1 || len(call.Named) > 0 {
+		return false
+	}
+
+	return parser.nextTokenIs(token.LEFTBRACE) && parser.nextToken.Line == parser.currentToken.Line
+}
+
+// callArguments parses the arguments of a function or method call. Named
+// arguments (name: value) may follow the positional arguments.
+func (parser *Parser) callArguments() ([]ast.ExpressionNode, []*ast.NamedArgument) {
+	arguments := []ast.ExpressionNode{}
+	var named []*ast.NamedArgument
+
+	if parser.nextTokenIs(token.RIGHTPAREN) {
+		parser.readToken()
+
+		return arguments, named
+	}
+
+	for {
+		parser.readToken()
+
+		if parser.currentTokenIs(token.IDENTIFIER) && parser.nextTokenIs(token.COLON) {
+			argument := &ast.NamedArgument{Token: parser.currentToken}
+			argument.Name = &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
+
+			for _, previous := range named {
+				if previous.Name.Value == argument.Name.Value {
+					parser.syntaxError(argument.Token, fmt.Sprintf("duplicate named argument `%s`", argument.Name.Value))
+				}
+			}
+
+			parser.readToken()
+			parser.readToken()
+
+			argument.Value = parser.parseExpression(LOWEST)
+			named = append(named, argument)
+		} else {
+			if len(named) > 0 {
+				parser.syntaxError(parser.currentToken, "positional argument follows named argument")
+			}
+
+			arguments = append(arguments, parser.parseExpression(LOWEST))
+		}
+
+		if !parser.nextTokenIs(token.COMMA) {
+			break
+		}
+
+		parser.readToken()
+	}
+
+	if !parser.expectNextTokenIs(token.RIGHTPAREN) {
+		return nil, nil
+	}
+
+	return arguments, named
+}
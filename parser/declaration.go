@@ -0,0 +1,50 @@
+package parser
+
+import (
+	"fmt"
+
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/token"
+)
+
+// declarationStatement parses a let or const declaration of an identifier or
+// destructuring pattern. Constants must be given a value.
+func (parser *Parser) declarationStatement() ast.StatementNode {
+	statement := &ast.Declaration{Token: parser.currentToken, Constant: parser.currentTokenIs(token.CONST)}
+
+	parser.readToken()
+
+	switch {
+	case parser.currentTokenIs(token.IDENTIFIER):
+		statement.Name = &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
+	case parser.currentTokenIs(token.LEFTBRACKET) || parser.currentTokenIs(token.LEFTBRACE):
+		statement.Name = parser.pattern(parser.parseExpression(LOWEST))
+
+		if statement.Name == nil {
+			return nil
+		}
+	default:
+		parser.syntaxError(parser.currentToken, fmt.Sprintf("expected identifier or destructuring pattern after `%s`", statement.Token.Lexeme))
+
+		return nil
+	}
+
+	if !parser.nextTokenIs(token.EQUAL) {
+		if _, ok := statement.Name.(*ast.Identifier); !ok || statement.Constant {
+			parser.syntaxError(statement.Token, fmt.Sprintf("missing value in `%s` declaration", statement.Token.Lexeme))
+
+			return nil
+		}
+	} else {
+		parser.readToken()
+		parser.readToken()
+
+		statement.Value = parser.parseExpression(LOWEST)
+	}
+
+	if parser.nextTokenIs(token.SEMICOLON) {
+		parser.readToken()
+	}
+
+	return statement
+}
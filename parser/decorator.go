@@ -0,0 +1,62 @@
+package parser
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/token"
+)
+
+// decoratedExpression parses one or more decorators and the function or class
+// declaration they apply to. Each decorator is an expression evaluating to a
+// callable, such as @memoize or @route("/users").
+func (parser *Parser) decoratedExpression() ast.ExpressionNode {
+	decorators := []*ast.Decorator{}
+
+	for {
+		decorator := &ast.Decorator{Token: parser.currentToken}
+
+		parser.readToken()
+
+		decorator.Expression = parser.parseExpression(LOWEST)
+
+		if decorator.Expression == nil {
+			return nil
+		}
+
+		decorators = append(decorators, decorator)
+
+		if !parser.nextTokenIs(token.AT) {
+			break
+		}
+
+		parser.readToken()
+	}
+
+	parser.readToken()
+
+	switch parser.currentToken.Type {
+	case token.FUNCTION:
+		function, ok := parser.functionStatement().(*ast.Function)
+
+		if !ok {
+			return nil
+		}
+
+		function.Decorators = decorators
+
+		return function
+	case token.CLASS:
+		class, ok := parser.classStatement().(*ast.Class)
+
+		if !ok {
+			return nil
+		}
+
+		class.Decorators = decorators
+
+		return class
+	}
+
+	parser.syntaxError(parser.currentToken, "decorators can only be applied to function and class declarations")
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package parser
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/token"
+)
+
+// destructure parses a statement beginning with a list or map literal. When
+// followed by "=", the literal is a pattern to destructure the value into.
+func (parser *Parser) destructure() ast.StatementNode {
+	expression := parser.parseExpression(LOWEST)
+
+	if !parser.nextTokenIs(token.EQUAL) {
+		return &ast.Expression{Expression: expression}
+	}
+
+	pattern := parser.pattern(expression)
+
+	if pattern == nil {
+		return nil
+	}
+
+	parser.previousIndex = nil
+	parser.previousProperty = nil
+
+	parser.readToken()
+
+	statement := &ast.Assign{Token: parser.currentToken, Name: pattern}
+
+	parser.readToken()
+
+	statement.Value = parser.parseExpression(LOWEST)
+
+	if parser.nextTokenIs(token.SEMICOLON) {
+		parser.readToken()
+	}
+
+	return statement
+}
+
+// pattern converts a parsed expression into an assignment target. List and map
+// literals become destructuring patterns, whose elements are converted in turn.
+func (parser *Parser) pattern(expression ast.ExpressionNode) ast.AssignmentNode {
+	switch expression := expression.(type) {
+	case *ast.Identifier, *ast.Index, *ast.Property:
+		return expression
+	case *ast.List:
+		pattern := &ast.ListPattern{Token: expression.Token, Closing: expression.Closing}
+
+		for index, element := range expression.Elements {
+			if spread, ok := element.(*ast.Spread); ok {
+				if index != len(expression.Elements)-1 {
+					parser.syntaxError(spread.Token, "rest element must be last in a list pattern")
+
+					return nil
+				}
+
+				pattern.Rest = parser.pattern(spread.Value)
+
+				if pattern.Rest == nil {
+					return nil
+				}
+
+				continue
+			}
+
+			target := parser.pattern(element)
+
+			if target == nil {
+				return nil
+			}
+
+			pattern.Elements = append(pattern.Elements, target)
+		}
+
+		return pattern
+	case *ast.Map:
+		pattern := &ast.MapPattern{Token: expression.Token, Closing: expression.Closing, Pairs: make(map[ast.ExpressionNode]ast.AssignmentNode)}
+
+		if len(expression.Keys) != len(expression.Pairs) {
+			parser.syntaxError(expression.Token, "map patterns cannot contain spread expressions")
+
+			return nil
+		}
+
+		for _, key := range expression.Keys {
+			value := expression.Pairs[key]
+
+			// Identifier keys name the key, as they do in map literals
+			if identifier, ok := key.(*ast.Identifier); ok {
+				key = &ast.String{Token: identifier.Token, Value: identifier.Value}
+			}
+
+			target := parser.pattern(value)
+
+			if target == nil {
+				return nil
+			}
+
+			pattern.Pairs[key] = target
+			pattern.Keys = append(pattern.Keys, key)
+		}
+
+		return pattern
+	}
+
+	parser.syntaxError(parser.currentToken, "invalid destructuring assignment target")
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"ghostlang.org/x/ghost/token"
+)
+
+// Diagnostic describes a syntax error found while parsing. It spans the
+// offending token, records the token that was expected and the one found
+// instead when known, and keeps the line of source it was found on so it can
+// be shown with the error underlined.
+type Diagnostic struct {
+	Reason    string
+	File      string
+	Line      int
+	Column    int
+	EndLine   int
+	EndColumn int        // Column just past the end of the offending token
+	Expected  token.Type // Empty if no particular token was expected
+	Actual    token.Type
+	Source    string // Line of source the diagnostic begins on
+}
+
+// newDiagnostic creates a diagnostic spanning the referenced token.
+func newDiagnostic(tok token.Token, reason string) *Diagnostic {
+	diagnostic := &Diagnostic{
+		Reason:    reason,
+		File:      tok.File,
+		Line:      tok.Line,
+		Column:    tok.Column,
+		EndLine:   tok.EndLine,
+		EndColumn: tok.EndColumn,
+		Actual:    tok.Type,
+	}
+
+	// Tokens the parser creates itself carry no end position
+	if tok.EndLine == 0 {
+		diagnostic.EndLine = tok.Line
+		diagnostic.EndColumn = tok.Column + max(utf8.RuneCountInString(tok.Lexeme), 1)
+	}
+
+	return diagnostic
+}
+
+// String represents the diagnostic as a single line error message, in the
+// same format as runtime errors.
+func (diagnostic *Diagnostic) String() string {
+	if diagnostic.File == "" {
+		return fmt.Sprintf("%d:%d: syntax error: %s", diagnostic.Line, diagnostic.Column, diagnostic.Reason)
+	}
+
+	return fmt.Sprintf("%d:%d:%s: syntax error: %s", diagnostic.Line, diagnostic.Column, diagnostic.File, diagnostic.Reason)
+}
+
+// Snippet represents the line of source the diagnostic was found on, with the
+// offending token underlined by carets:
+//
+//	3 | x = (1 + )
+//	  |          ^
+func (diagnostic *Diagnostic) Snippet() string {
+	if diagnostic.Source == "" {
+		return ""
+	}
+
+	gutter := fmt.Sprintf("%d", diagnostic.Line)
+	padding := strings.Repeat(" ", len(gutter))
+
+	var underline strings.Builder
+
+	// Tabs are kept so the carets line up with the source however wide the
+	// terminal renders them.
+	for index, character := range []rune(diagnostic.Source) {
+		if index >= diagnostic.Column-1 {
+			break
+		}
+
+		if character == '\t' {
+			underline.WriteRune('\t')
+		} else {
+			underline.WriteRune(' ')
+		}
+	}
+
+	width := diagnostic.EndColumn - diagnostic.Column
+
+	if diagnostic.EndLine != diagnostic.Line || width < 1 {
+		width = 1
+	}
+
+	underline.WriteString(strings.Repeat("^", width))
+
+	return fmt.Sprintf("%s | %s\n%s | %s", gutter, diagnostic.Source, padding, underline.String())
+}
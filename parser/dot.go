@@ -18,14 +18,22 @@ func (parser *Parser) dotExpression(left ast.ExpressionNode) ast.ExpressionNode
 
 		parser.readToken()
 
-		expression.Arguments = parser.parseExpressionList(token.RIGHTPAREN)
+		expression.Arguments, expression.Named = parser.callArguments()
+		expression.Closing = parser.currentToken
 
 		return expression
 	}
 
-	// Property
+	// Property names are plain identifiers, so an increment or decrement
+	// after them applies to the whole property
+	if !parser.currentTokenIs(token.IDENTIFIER) {
+		parser.unexpectedError()
+
+		return nil
+	}
+
 	expression := &ast.Property{Token: currentToken, Left: left}
-	expression.Property = parser.parseExpression(currentPrecedence)
+	expression.Property = &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
 
 	parser.previousProperty = expression
 	parser.previousIndex = nil
@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"fmt"
+
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/token"
+)
+
+// enumStatement parses an enum declaration. Members are separated by commas,
+// and may be given associated values as named arguments:
+//
+//	enum Status { Active, Banned(reason: "spam") }
+func (parser *Parser) enumStatement() ast.ExpressionNode {
+	enum := &ast.Enum{Token: parser.currentToken}
+
+	if !parser.expectNextTokenIs(token.IDENTIFIER) {
+		return nil
+	}
+
+	enum.Name = &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
+
+	if !parser.expectNextTokenIs(token.LEFTBRACE) {
+		return nil
+	}
+
+	for !parser.nextTokenIs(token.RIGHTBRACE) {
+		if !parser.expectNextTokenIs(token.IDENTIFIER) {
+			return nil
+		}
+
+		member := &ast.EnumMember{Token: parser.currentToken}
+		member.Name = &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
+
+		for _, previous := range enum.Members {
+			if previous.Name.Value == member.Name.Value {
+				parser.syntaxError(member.Token, fmt.Sprintf("duplicate enum member `%s`", member.Name.Value))
+			}
+		}
+
+		if parser.nextTokenIs(token.LEFTPAREN) {
+			parser.readToken()
+
+			arguments, named := parser.callArguments()
+			member.Closing = parser.currentToken
+
+			if len(arguments) > 0 {
+				parser.syntaxError(member.Token, fmt.Sprintf("associated values of enum member `%s` must be named", member.Name.Value))
+			}
+
+			member.Values = named
+		}
+
+		enum.Members = append(enum.Members, member)
+
+		if !parser.nextTokenIs(token.RIGHTBRACE) && !parser.expectNextTokenIs(token.COMMA) {
+			return nil
+		}
+	}
+
+	parser.readToken()
+
+	enum.Closing = parser.currentToken
+
+	return enum
+}
@@ -15,10 +15,12 @@ func (parser *Parser) parseExpression(precedence int) ast.ExpressionNode {
 	prefix := parser.prefixParserFns[parser.currentToken.Type]
 
 	if prefix == nil {
+		parser.unexpectedError()
+
 		return nil
 	}
 
-	leftExpression := prefix()
+	leftExpression := parser.postfixOf(prefix())
 
 	for !parser.nextTokenIs(token.SEMICOLON) && precedence < parser.nextTokenPrecedence() {
 		infix := parser.infixParserFns[parser.nextToken.Type]
@@ -27,14 +29,42 @@ func (parser *Parser) parseExpression(precedence int) ast.ExpressionNode {
 			return leftExpression
 		}
 
+		// A "[" starting a new line begins a new statement, such as a list
+		// destructuring assignment, rather than indexing the expression before it
+		if parser.nextTokenIs(token.LEFTBRACKET) && parser.nextToken.Line > parser.currentToken.Line {
+			return leftExpression
+		}
+
 		parser.readToken()
 
-		leftExpression = infix(leftExpression)
+		leftExpression = parser.postfixOf(infix(leftExpression))
 	}
 
 	return leftExpression
 }
 
+// postfixOf applies an increment or decrement following an identifier, index
+// or property to it. The postfix expression may be followed by further
+// operators, as in "i++ < 3".
+func (parser *Parser) postfixOf(expression ast.ExpressionNode) ast.ExpressionNode {
+	switch expression.(type) {
+	case *ast.Identifier, *ast.Index, *ast.Property:
+	default:
+		return expression
+	}
+
+	if !parser.nextTokenIs(token.PLUSPLUS) && !parser.nextTokenIs(token.MINUSMINUS) {
+		return expression
+	}
+
+	parser.readToken()
+
+	postfix := parser.postfixExpression().(*ast.Postfix)
+	postfix.Left = expression
+
+	return postfix
+}
+
 func (parser *Parser) parseExpressionList(end token.Type) []ast.ExpressionNode {
 	list := []ast.ExpressionNode{}
 
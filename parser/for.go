@@ -14,7 +14,13 @@ func (parser *Parser) forExpression() ast.ExpressionNode {
 
 	parser.readToken()
 
+	if parser.currentTokenIs(token.LEFTBRACKET) || parser.currentTokenIs(token.LEFTBRACE) {
+		return parser.forInExpression(expression)
+	}
+
 	if !parser.currentTokenIs(token.IDENTIFIER) {
+		parser.syntaxError(parser.currentToken, "expected identifier or destructuring pattern in for loop, got "+describe(parser.currentToken))
+
 		return nil
 	}
 
@@ -62,31 +68,34 @@ func (parser *Parser) forExpression() ast.ExpressionNode {
 func (parser *Parser) forInExpression(parent *ast.For) ast.ExpressionNode {
 	expression := &ast.ForIn{Token: parent.Token}
 
-	if !parser.currentTokenIs(token.IDENTIFIER) {
+	expression.Key = &ast.Identifier{}
+	expression.Value = parser.forInTarget()
+
+	if expression.Value == nil {
 		return nil
 	}
 
-	value := ast.Identifier{Value: parser.currentToken.Lexeme}
-	key := ast.Identifier{}
-
 	parser.readToken()
 
 	if parser.currentTokenIs(token.COMMA) {
-		parser.readToken()
+		key, ok := expression.Value.(*ast.Identifier)
 
-		if !parser.currentTokenIs(token.IDENTIFIER) {
+		if !ok {
 			return nil
 		}
 
-		key = value
-		value.Value = parser.currentToken.Lexeme
+		parser.readToken()
+
+		expression.Key = key
+		expression.Value = parser.forInTarget()
+
+		if expression.Value == nil {
+			return nil
+		}
 
 		parser.readToken()
 	}
 
-	expression.Key = &key
-	expression.Value = &value
-
 	if !parser.currentTokenIs(token.IN) {
 		return nil
 	}
@@ -108,6 +117,20 @@ func (parser *Parser) forInExpression(parent *ast.For) ast.ExpressionNode {
 	return expression
 }
 
+// forInTarget parses the value a for in loop assigns each element to, which is
+// either an identifier or a list or map destructuring pattern.
+func (parser *Parser) forInTarget() ast.AssignmentNode {
+	if parser.currentTokenIs(token.IDENTIFIER) {
+		return &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
+	}
+
+	if parser.currentTokenIs(token.LEFTBRACKET) || parser.currentTokenIs(token.LEFTBRACE) {
+		return parser.pattern(parser.parseExpression(LOWEST))
+	}
+
+	return nil
+}
+
 // forIncrement parses the increment expression of a for loop.
 // It can be an assignment (x = x + 1), a postfix expression (x++), or an infix expression (x += 1).
 func (parser *Parser) forIncrement() ast.ExpressionNode {
@@ -124,10 +147,7 @@ func (parser *Parser) forIncrement() ast.ExpressionNode {
 		return parser.assign()
 	}
 
-	if parser.currentTokenIs(token.IDENTIFIER) && (parser.nextTokenIs(token.PLUSEQUAL) ||
-		parser.nextTokenIs(token.MINUSEQUAL) ||
-		parser.nextTokenIs(token.SLASHEQUAL) ||
-		parser.nextTokenIs(token.STAREQUAL)) {
+	if parser.currentTokenIs(token.IDENTIFIER) && parser.nextTokenPrecedence() == ASSIGN {
 		identifier := parser.identifierLiteral()
 
 		parser.readToken()
@@ -18,30 +18,55 @@ func (parser *Parser) functionStatement() ast.ExpressionNode {
 		return nil
 	}
 
-	expression.Defaults, expression.Parameters = parser.functionParameters()
+	expression.Defaults, expression.Parameters, expression.Rest = parser.functionParameters()
 
 	if !parser.expectNextTokenIs(token.LEFTBRACE) {
 		return nil
 	}
 
+	parser.enterFunctionBody()
+
 	expression.Body = parser.blockStatement()
+	expression.Generator = parser.leaveFunctionBody()
 
 	return expression
 }
 
-func (parser *Parser) functionParameters() (map[string]ast.ExpressionNode, []*ast.Identifier) {
+// functionParameters parses the parameters of a function, along with their
+// default values and the optional trailing rest parameter (...name).
+func (parser *Parser) functionParameters() (map[string]ast.ExpressionNode, []*ast.Identifier, *ast.Identifier) {
 	defaults := make(map[string]ast.ExpressionNode)
 	parameters := []*ast.Identifier{}
 
 	if parser.nextTokenIs(token.RIGHTPAREN) {
 		parser.readToken()
 
-		return defaults, parameters
+		return defaults, parameters, nil
 	}
 
 	parser.readToken()
 
 	for !parser.currentTokenIs(token.RIGHTPAREN) {
+		if parser.currentTokenIs(token.ELLIPSIS) {
+			if !parser.expectNextTokenIs(token.IDENTIFIER) {
+				return defaults, parameters, nil
+			}
+
+			rest := &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
+
+			if !parser.expectNextTokenIs(token.RIGHTPAREN) {
+				return defaults, parameters, nil
+			}
+
+			return defaults, parameters, rest
+		}
+
+		if !parser.currentTokenIs(token.IDENTIFIER) {
+			parser.syntaxError(parser.currentToken, "expected parameter name, got "+describe(parser.currentToken))
+
+			return defaults, parameters, nil
+		}
+
 		parameter := &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
 		parameters = append(parameters, parameter)
 
@@ -60,5 +85,5 @@ func (parser *Parser) functionParameters() (map[string]ast.ExpressionNode, []*as
 		}
 	}
 
-	return defaults, parameters
+	return defaults, parameters, nil
 }
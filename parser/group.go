@@ -6,14 +6,45 @@ import (
 )
 
 func (parser *Parser) groupExpression() ast.ExpressionNode {
+	opening := parser.currentToken
+
+	// An empty pair of parentheses can only start an arrow function
+	if parser.nextTokenIs(token.RIGHTPAREN) {
+		return parser.arrowFunction(opening, nil)
+	}
+
 	// Read the opening token.LEFTPAREN ("(")
 	parser.readToken()
 
 	group := parser.parseExpression(LOWEST)
 
+	// Multiple values, a default value or a rest parameter can only be arrow
+	// function parameters
+	_, rest := group.(*ast.Spread)
+
+	if rest || parser.nextTokenIs(token.COMMA) || parser.nextTokenIs(token.EQUAL) {
+		return parser.arrowFunction(opening, group)
+	}
+
 	if !parser.expectNextTokenIs(token.RIGHTPAREN) {
 		return nil
 	}
 
+	if parser.nextTokenIs(token.ARROW) {
+		parameter := parser.arrowParameter(group)
+
+		if parameter == nil {
+			return nil
+		}
+
+		parser.readToken()
+
+		return parser.arrowBody(&ast.Function{
+			Opening:    opening,
+			Defaults:   make(map[string]ast.ExpressionNode),
+			Parameters: []*ast.Identifier{parameter},
+		})
+	}
+
 	return group
 }
@@ -2,10 +2,21 @@ package parser
 
 import (
 	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/token"
 )
 
 func (parser *Parser) identifierLiteral() ast.ExpressionNode {
 	identifier := &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
 
+	// A single parameter arrow function: x => x * 2
+	if parser.nextTokenIs(token.ARROW) {
+		parser.readToken()
+
+		return parser.arrowBody(&ast.Function{
+			Defaults:   make(map[string]ast.ExpressionNode),
+			Parameters: []*ast.Identifier{identifier},
+		})
+	}
+
 	return identifier
 }
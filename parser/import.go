@@ -29,17 +29,27 @@ func (parser *Parser) importFromStatement(parent *ast.Import) ast.ExpressionNode
 
 		parser.readToken()
 	} else if !parser.currentTokenIs(token.IDENTIFIER) {
+		parser.syntaxError(parser.currentToken, "expected a path, `*` or identifiers to import, got "+describe(parser.currentToken))
+
 		return nil
 	}
 
 	for !parser.currentTokenIs(token.FROM) {
-		identifier := &ast.Identifier{Value: parser.currentToken.Lexeme}
+		if !parser.currentTokenIs(token.IDENTIFIER) {
+			parser.syntaxError(parser.currentToken, "expected identifier or `from` in import, got "+describe(parser.currentToken))
+
+			return nil
+		}
+
+		identifier := &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
 		alias := parser.currentToken.Lexeme
 
 		parser.readToken()
 
 		if parser.currentTokenIs(token.AS) {
-			parser.readToken()
+			if !parser.expectNextTokenIs(token.IDENTIFIER) {
+				return nil
+			}
 
 			alias = parser.currentToken.Lexeme
 
@@ -47,16 +57,13 @@ func (parser *Parser) importFromStatement(parent *ast.Import) ast.ExpressionNode
 		}
 
 		statement.Identifiers[alias] = identifier
+		statement.Aliases = append(statement.Aliases, alias)
 
 		if parser.currentTokenIs(token.COMMA) {
 			parser.readToken()
 		}
 	}
 
-	if !parser.currentTokenIs(token.FROM) {
-		return nil
-	}
-
 	if !parser.expectNextTokenIs(token.STRING) {
 		return nil
 	}
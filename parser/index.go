@@ -16,6 +16,8 @@ func (parser *Parser) indexExpression(left ast.ExpressionNode) ast.ExpressionNod
 		return nil
 	}
 
+	expression.Closing = parser.currentToken
+
 	parser.previousIndex = expression
 	parser.previousProperty = nil
 
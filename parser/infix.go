@@ -13,6 +13,11 @@ func (parser *Parser) infixExpression(left ast.ExpressionNode) ast.ExpressionNod
 
 	precedence := parser.currentTokenPrecedence()
 
+	// Exponents are right associative, so 2 ** 3 ** 2 is 2 ** (3 ** 2)
+	if infix.Operator == "**" {
+		precedence--
+	}
+
 	parser.readToken()
 
 	infix.Right = parser.parseExpression(precedence)
@@ -0,0 +1,55 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/scanner"
+	"ghostlang.org/x/ghost/token"
+)
+
+func (parser *Parser) interpolationLiteral() ast.ExpressionNode {
+	interpolation := &ast.Interpolation{Token: parser.currentToken}
+
+	for _, fragment := range parser.currentToken.Literal.([]token.Fragment) {
+		if !fragment.Expression {
+			interpolation.Parts = append(interpolation.Parts, &ast.String{Token: parser.currentToken, Value: fragment.Value})
+
+			continue
+		}
+
+		if fragment.Unterminated {
+			tok := token.Token{Type: parser.currentToken.Type, Lexeme: strings.TrimSpace(fragment.Value), Line: fragment.Line, Column: fragment.Column, File: parser.currentToken.File}
+
+			parser.syntaxError(tok, "unterminated interpolation: expected `}` before the end of the file")
+
+			return nil
+		}
+
+		// Each embedded expression is parsed on its own, starting from where
+		// it was found within the string.
+		fragmentParser := New(scanner.NewAt(fragment.Value, parser.currentToken.File, fragment.Line, fragment.Column))
+		expression := fragmentParser.parseExpression(LOWEST)
+
+		// The fragment parser only knows the embedded expression, so the
+		// source of each diagnostic is looked up again in the whole file.
+		for _, diagnostic := range fragmentParser.diagnostics {
+			diagnostic.Source = ""
+
+			parser.report(diagnostic)
+		}
+
+		if expression == nil || fragmentParser.currentTokenIs(token.EOF) || !fragmentParser.nextTokenIs(token.EOF) {
+			tok := token.Token{Type: parser.currentToken.Type, Lexeme: fragment.Value, Line: fragment.Line, Column: fragment.Column, File: parser.currentToken.File}
+
+			parser.syntaxError(tok, fmt.Sprintf("invalid expression in string interpolation: `${%s}`", fragment.Value))
+
+			return nil
+		}
+
+		interpolation.Parts = append(interpolation.Parts, expression)
+	}
+
+	return interpolation
+}
@@ -9,6 +9,7 @@ func (parser *Parser) listLiteral() ast.ExpressionNode {
 	list := &ast.List{Token: parser.currentToken}
 
 	list.Elements = parser.parseExpressionList(token.RIGHTBRACKET)
+	list.Closing = parser.currentToken
 
 	return list
 }
@@ -12,8 +12,32 @@ func (parser *Parser) mapLiteral() ast.ExpressionNode {
 	for !parser.nextTokenIs(token.RIGHTBRACE) {
 		parser.readToken()
 
+		if parser.currentTokenIs(token.ELLIPSIS) {
+			mapLiteral.Keys = append(mapLiteral.Keys, parser.spreadExpression())
+
+			if !parser.nextTokenIs(token.RIGHTBRACE) && !parser.expectNextTokenIs(token.COMMA) {
+				return nil
+			}
+
+			continue
+		}
+
 		key := parser.parseExpression(LOWEST)
 
+		// {name} is shorthand for {name: name}
+		if identifier, ok := key.(*ast.Identifier); ok && (parser.nextTokenIs(token.COMMA) || parser.nextTokenIs(token.RIGHTBRACE)) {
+			key = &ast.Identifier{Token: identifier.Token, Value: identifier.Value}
+
+			mapLiteral.Pairs[key] = identifier
+			mapLiteral.Keys = append(mapLiteral.Keys, key)
+
+			if parser.nextTokenIs(token.COMMA) {
+				parser.readToken()
+			}
+
+			continue
+		}
+
 		if !parser.expectNextTokenIs(token.COLON) {
 			return nil
 		}
@@ -23,6 +47,7 @@ func (parser *Parser) mapLiteral() ast.ExpressionNode {
 		value := parser.parseExpression(LOWEST)
 
 		mapLiteral.Pairs[key] = value
+		mapLiteral.Keys = append(mapLiteral.Keys, key)
 
 		if !parser.nextTokenIs(token.RIGHTBRACE) && !parser.expectNextTokenIs(token.COMMA) {
 			return nil
@@ -33,5 +58,7 @@ func (parser *Parser) mapLiteral() ast.ExpressionNode {
 		return nil
 	}
 
+	mapLiteral.Closing = parser.currentToken
+
 	return mapLiteral
 }
@@ -0,0 +1,155 @@
+package parser
+
+import (
+	"fmt"
+
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/token"
+)
+
+// matchExpression parses the cases of a match expression, following its
+// parenthesized value. The "match" keyword is contextual: it is parsed as a
+// call of an identifier named match until a block of cases follows the call
+// on the same line, so match remains usable as an identifier.
+func (parser *Parser) matchExpression(tok token.Token, value ast.ExpressionNode) ast.ExpressionNode {
+	expression := &ast.Match{Token: tok, Value: value}
+
+	if value == nil {
+		return nil
+	}
+
+	if !parser.expectNextTokenIs(token.LEFTBRACE) {
+		return nil
+	}
+
+	parser.readToken()
+
+	defaults := 0
+
+	for !parser.currentTokenIs(token.RIGHTBRACE) {
+		matchCase := &ast.MatchCase{Token: parser.currentToken}
+
+		switch parser.currentToken.Type {
+		case token.DEFAULT:
+			matchCase.Default = true
+			defaults++
+		case token.CASE:
+			if !parser.matchPatterns(matchCase) {
+				return nil
+			}
+		default:
+			parser.syntaxError(parser.currentToken, fmt.Sprintf("expected `case` or `default` in match expression, got `%s`", parser.currentToken.Lexeme))
+
+			return nil
+		}
+
+		if !parser.expectNextTokenIs(token.LEFTBRACE) {
+			return nil
+		}
+
+		matchCase.Body = parser.blockStatement()
+
+		parser.readToken()
+
+		expression.Cases = append(expression.Cases, matchCase)
+	}
+
+	expression.Closing = parser.currentToken
+
+	if defaults > 1 {
+		parser.syntaxError(expression.Token, "multiple default cases in match expression")
+
+		return nil
+	}
+
+	return expression
+}
+
+// matchPatterns parses the comma separated patterns of a match case, followed
+// by its optional "if" guard.
+func (parser *Parser) matchPatterns(matchCase *ast.MatchCase) bool {
+	for {
+		parser.readToken()
+
+		pattern := parser.parseExpression(LOWEST)
+
+		if !isMatchPattern(pattern) {
+			parser.syntaxError(matchCase.Token, "invalid pattern in match case")
+
+			return false
+		}
+
+		matchCase.Patterns = append(matchCase.Patterns, pattern)
+
+		if !parser.nextTokenIs(token.COMMA) {
+			break
+		}
+
+		parser.readToken()
+	}
+
+	if parser.nextTokenIs(token.IF) {
+		parser.readToken()
+		parser.readToken()
+
+		matchCase.Guard = parser.parseExpression(LOWEST)
+	}
+
+	return true
+}
+
+// isMatchPattern determines if the parsed expression can be used as a match
+// pattern. Patterns are literals, ranges, identifiers to bind, lists and maps
+// of patterns, and class patterns written as ClassName(pattern).
+func isMatchPattern(pattern ast.ExpressionNode) bool {
+	switch pattern := pattern.(type) {
+	case *ast.Number, *ast.String, *ast.Boolean, *ast.Null, *ast.Identifier, *ast.Property, *ast.Range:
+		return true
+	case *ast.Prefix:
+		_, ok := pattern.Right.(*ast.Number)
+
+		return ok && pattern.Operator == "-"
+	case *ast.List:
+		for index, element := range pattern.Elements {
+			if spread, ok := element.(*ast.Spread); ok {
+				if _, ok := spread.Value.(*ast.Identifier); !ok || index != len(pattern.Elements)-1 {
+					return false
+				}
+
+				continue
+			}
+
+			if !isMatchPattern(element) {
+				return false
+			}
+		}
+
+		return true
+	case *ast.Map:
+		if len(pattern.Keys) != len(pattern.Pairs) {
+			return false
+		}
+
+		for _, value := range pattern.Pairs {
+			if !isMatchPattern(value) {
+				return false
+			}
+		}
+
+		return true
+	case *ast.Call:
+		switch pattern.Callee.(type) {
+		case *ast.Identifier, *ast.Property:
+		default:
+			return false
+		}
+
+		if len(pattern.Named) > 0 || len(pattern.Arguments) > 1 {
+			return false
+		}
+
+		return len(pattern.Arguments) == 0 || isMatchPattern(pattern.Arguments[0])
+	}
+
+	return false
+}
@@ -0,0 +1,36 @@
+package parser
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/token"
+)
+
+// optionalChainExpression parses a property, method or index access made with
+// "?.". When the left side is null, the access and the rest of the chain after
+// it evaluate to null instead of failing.
+func (parser *Parser) optionalChainExpression(left ast.ExpressionNode) ast.ExpressionNode {
+	var expression ast.ExpressionNode
+
+	if parser.nextTokenIs(token.LEFTBRACKET) {
+		parser.readToken()
+
+		expression = parser.indexExpression(left)
+	} else {
+		expression = parser.dotExpression(left)
+	}
+
+	// Optional accesses cannot be assigned to
+	parser.previousIndex = nil
+	parser.previousProperty = nil
+
+	switch expression := expression.(type) {
+	case *ast.Index:
+		expression.Optional = true
+	case *ast.Property:
+		expression.Optional = true
+	case *ast.Method:
+		expression.Optional = true
+	}
+
+	return expression
+}
@@ -10,45 +10,69 @@ import (
 
 // precedences contains a list of tokens mapped to their precedence level.
 var precedences = map[token.Type]int{
-	token.OR:           OR,
-	token.AND:          AND,
-	token.EQUALEQUAL:   EQUALS,
-	token.BANGEQUAL:    EQUALS,
-	token.IN:           EQUALS,
-	token.LESS:         LESSGREATER,
-	token.LESSEQUAL:    LESSGREATER,
-	token.GREATER:      LESSGREATER,
-	token.GREATEREQUAL: LESSGREATER,
-	token.PLUS:         SUM,
-	token.MINUS:        SUM,
-	token.STAR:         PRODUCT,
-	token.SLASH:        PRODUCT,
-	token.PERCENT:      MODULO,
-	token.LEFTPAREN:    CALL,
-	token.LEFTBRACKET:  INDEX,
-	token.DOT:          INDEX,
-	token.PLUSEQUAL:    SUM,
-	token.MINUSEQUAL:   SUM,
-	token.STAREQUAL:    PRODUCT,
-	token.SLASHEQUAL:   PRODUCT,
-	token.DOTDOT:       RANGE,
-	token.QUESTION:     TERNARY,
+	token.OR:                  OR,
+	token.AND:                 AND,
+	token.EQUALEQUAL:          EQUALS,
+	token.BANGEQUAL:           EQUALS,
+	token.IN:                  EQUALS,
+	token.LESS:                LESSGREATER,
+	token.LESSEQUAL:           LESSGREATER,
+	token.GREATER:             LESSGREATER,
+	token.GREATEREQUAL:        LESSGREATER,
+	token.PLUS:                SUM,
+	token.MINUS:               SUM,
+	token.STAR:                PRODUCT,
+	token.SLASH:               PRODUCT,
+	token.PERCENT:             MODULO,
+	token.LEFTPAREN:           CALL,
+	token.LEFTBRACKET:         INDEX,
+	token.DOT:                 INDEX,
+	token.PIPE:                BITWISEOR,
+	token.CARET:               BITWISEXOR,
+	token.AMPERSAND:           BITWISEAND,
+	token.LESSLESS:            SHIFT,
+	token.GREATERGREATER:      SHIFT,
+	token.TILDESLASH:          PRODUCT,
+	token.STARSTAR:            EXPONENT,
+	token.PLUSEQUAL:           ASSIGN,
+	token.MINUSEQUAL:          ASSIGN,
+	token.STAREQUAL:           ASSIGN,
+	token.SLASHEQUAL:          ASSIGN,
+	token.STARSTAREQUAL:       ASSIGN,
+	token.PERCENTEQUAL:        ASSIGN,
+	token.TILDESLASHEQUAL:     ASSIGN,
+	token.AMPERSANDEQUAL:      ASSIGN,
+	token.PIPEEQUAL:           ASSIGN,
+	token.CARETEQUAL:          ASSIGN,
+	token.LESSLESSEQUAL:       ASSIGN,
+	token.GREATERGREATEREQUAL: ASSIGN,
+	token.DOTDOT:              RANGE,
+	token.QUESTION:            TERNARY,
+	token.QUESTIONDOT:         INDEX,
+	token.QUESTIONQUESTION:    COALESCE,
 }
 
 // The following list of constants define the available precedence levels.
 const (
 	_ int = iota
 	LOWEST
+	ASSIGN
+	COALESCE
 	OR
 	AND
 	TERNARY
 	RANGE
 	EQUALS
 	LESSGREATER
+	BITWISEOR
+	BITWISEXOR
+	BITWISEAND
+	SHIFT
 	SUM
 	PRODUCT
 	MODULO
 	PREFIX
+	EXPONENT
 	CALL
 	INDEX
 )
@@ -59,11 +83,16 @@ type (
 	postfixParserFn func() ast.ExpressionNode
 )
 
-// Parser holds a slice of tokens, its position, and errors
+// Parser holds a slice of tokens, its position, and diagnostics
 // as well as the prefix, infix, and postfix parse functions.
 type Parser struct {
-	scanner *scanner.Scanner
-	errors  []string
+	scanner     *scanner.Scanner
+	diagnostics []*Diagnostic
+
+	// panicking is set once a statement has reported a syntax error, so the
+	// errors that follow from it are not reported until the parser has
+	// synchronized with the next statement.
+	panicking bool
 
 	previousToken token.Token
 	currentToken  token.Token
@@ -77,13 +106,17 @@ type Parser struct {
 	postfixParserFns map[token.Type]postfixParserFn
 
 	inTernaryExpression bool
+
+	// generators tracks the function bodies being parsed, innermost last, and
+	// whether each contains a yield expression.
+	generators []bool
 }
 
 // New creates a new parser instance.
 func New(scanner *scanner.Scanner) *Parser {
 	parser := &Parser{
 		scanner:          scanner,
-		errors:           []string{},
+		diagnostics:      []*Diagnostic{},
 		prefixParserFns:  make(map[token.Type]prefixParserFn),
 		infixParserFns:   make(map[token.Type]infixParserFn),
 		postfixParserFns: make(map[token.Type]postfixParserFn),
@@ -96,8 +129,10 @@ func New(scanner *scanner.Scanner) *Parser {
 	parser.registerPrefix(token.TRUE, parser.booleanLiteral)
 	parser.registerPrefix(token.FALSE, parser.booleanLiteral)
 	parser.registerPrefix(token.STRING, parser.stringLiteral)
+	parser.registerPrefix(token.INTERPOLATION, parser.interpolationLiteral)
 	parser.registerPrefix(token.BANG, parser.prefixExpression)
 	parser.registerPrefix(token.MINUS, parser.prefixExpression)
+	parser.registerPrefix(token.TILDE, parser.prefixExpression)
 	parser.registerPrefix(token.IF, parser.ifExpression)
 	parser.registerPrefix(token.LEFTPAREN, parser.groupExpression)
 	parser.registerPrefix(token.FUNCTION, parser.functionStatement)
@@ -106,13 +141,20 @@ func New(scanner *scanner.Scanner) *Parser {
 	parser.registerPrefix(token.WHILE, parser.whileExpression)
 	parser.registerPrefix(token.FOR, parser.forExpression)
 	parser.registerPrefix(token.CLASS, parser.classStatement)
+	parser.registerPrefix(token.ENUM, parser.enumStatement)
 	parser.registerPrefix(token.TRAIT, parser.traitStatement)
 	parser.registerPrefix(token.USE, parser.useExpression)
 	parser.registerPrefix(token.THIS, parser.thisExpression)
+	parser.registerPrefix(token.SUPER, parser.superExpression)
 	parser.registerPrefix(token.IMPORT, parser.importStatement)
 	parser.registerPrefix(token.SWITCH, parser.switchStatement)
 	parser.registerPrefix(token.BREAK, parser.breakStatement)
 	parser.registerPrefix(token.CONTINUE, parser.continueStatement)
+	parser.registerPrefix(token.TRY, parser.tryExpression)
+	parser.registerPrefix(token.THROW, parser.throwExpression)
+	parser.registerPrefix(token.ELLIPSIS, parser.spreadExpression)
+	parser.registerPrefix(token.YIELD, parser.yieldExpression)
+	parser.registerPrefix(token.AT, parser.decoratedExpression)
 
 	// Register all of our infix parse functions
 	parser.registerInfix(token.PLUS, parser.infixExpression)
@@ -120,6 +162,13 @@ func New(scanner *scanner.Scanner) *Parser {
 	parser.registerInfix(token.SLASH, parser.infixExpression)
 	parser.registerInfix(token.STAR, parser.infixExpression)
 	parser.registerInfix(token.PERCENT, parser.infixExpression)
+	parser.registerInfix(token.STARSTAR, parser.infixExpression)
+	parser.registerInfix(token.TILDESLASH, parser.infixExpression)
+	parser.registerInfix(token.AMPERSAND, parser.infixExpression)
+	parser.registerInfix(token.PIPE, parser.infixExpression)
+	parser.registerInfix(token.CARET, parser.infixExpression)
+	parser.registerInfix(token.LESSLESS, parser.infixExpression)
+	parser.registerInfix(token.GREATERGREATER, parser.infixExpression)
 	parser.registerInfix(token.EQUALEQUAL, parser.infixExpression)
 	parser.registerInfix(token.BANGEQUAL, parser.infixExpression)
 	parser.registerInfix(token.GREATER, parser.infixExpression)
@@ -131,12 +180,22 @@ func New(scanner *scanner.Scanner) *Parser {
 	parser.registerInfix(token.DOT, parser.dotExpression)
 	parser.registerInfix(token.AND, parser.infixExpression)
 	parser.registerInfix(token.OR, parser.infixExpression)
-	parser.registerInfix(token.DOTDOT, parser.infixExpression)
+	parser.registerInfix(token.DOTDOT, parser.rangeExpression)
 	parser.registerInfix(token.PLUSEQUAL, parser.compoundExpression)
 	parser.registerInfix(token.MINUSEQUAL, parser.compoundExpression)
 	parser.registerInfix(token.STAREQUAL, parser.compoundExpression)
 	parser.registerInfix(token.SLASHEQUAL, parser.compoundExpression)
+	parser.registerInfix(token.STARSTAREQUAL, parser.compoundExpression)
+	parser.registerInfix(token.PERCENTEQUAL, parser.compoundExpression)
+	parser.registerInfix(token.TILDESLASHEQUAL, parser.compoundExpression)
+	parser.registerInfix(token.AMPERSANDEQUAL, parser.compoundExpression)
+	parser.registerInfix(token.PIPEEQUAL, parser.compoundExpression)
+	parser.registerInfix(token.CARETEQUAL, parser.compoundExpression)
+	parser.registerInfix(token.LESSLESSEQUAL, parser.compoundExpression)
+	parser.registerInfix(token.GREATERGREATEREQUAL, parser.compoundExpression)
 	parser.registerInfix(token.QUESTION, parser.ternaryExpression)
+	parser.registerInfix(token.QUESTIONDOT, parser.optionalChainExpression)
+	parser.registerInfix(token.QUESTIONQUESTION, parser.infixExpression)
 
 	// Register all of our postfix parse functions
 	parser.registerPostfix(token.PLUSPLUS, parser.postfixExpression)
@@ -171,7 +230,7 @@ func (parser *Parser) Parse() *ast.Program {
 	program.Statements = []ast.StatementNode{}
 
 	for !parser.isAtEnd() {
-		statement := parser.statement()
+		statement := parser.recoverableStatement()
 
 		program.Statements = append(program.Statements, statement)
 
@@ -181,9 +240,21 @@ func (parser *Parser) Parse() *ast.Program {
 	return program
 }
 
-// Errors returns the slice of errors contained within the parser instance.
+// Errors returns the syntax errors found by the parser as messages.
 func (parser *Parser) Errors() []string {
-	return parser.errors
+	errors := make([]string, len(parser.diagnostics))
+
+	for index, diagnostic := range parser.diagnostics {
+		errors[index] = diagnostic.String()
+	}
+
+	return errors
+}
+
+// Diagnostics returns the syntax errors found by the parser, in the order
+// they were found.
+func (parser *Parser) Diagnostics() []*Diagnostic {
+	return parser.diagnostics
 }
 
 // =============================================================================
@@ -203,11 +274,91 @@ func (parser *Parser) isAtEnd() bool {
 }
 
 func (parser *Parser) nextError(tt token.Type) {
-	message := fmt.Sprintf(
-		"%d:%d: syntax error: expected next token to be `%s`, got: `%s` instead", parser.nextToken.Line, parser.nextToken.Column, tt, parser.nextToken.Type,
-	)
+	diagnostic := newDiagnostic(parser.nextToken, fmt.Sprintf("expected next token to be `%s`, got: `%s` instead", tt, parser.nextToken.Type))
+	diagnostic.Expected = tt
 
-	parser.errors = append(parser.errors, message)
+	parser.report(diagnostic)
+}
+
+// unexpectedError records a syntax error for a current token that cannot
+// begin an expression.
+func (parser *Parser) unexpectedError() {
+	parser.syntaxError(parser.currentToken, "unexpected "+describe(parser.currentToken))
+}
+
+// syntaxError records a syntax error at the referenced token.
+func (parser *Parser) syntaxError(tok token.Token, reason string) {
+	parser.report(newDiagnostic(tok, reason))
+}
+
+// report records a diagnostic, unless the parser is still recovering from an
+// earlier syntax error in the same statement.
+func (parser *Parser) report(diagnostic *Diagnostic) {
+	if parser.panicking {
+		return
+	}
+
+	if diagnostic.Source == "" {
+		diagnostic.Source = parser.scanner.Line(diagnostic.Line)
+	}
+
+	parser.diagnostics = append(parser.diagnostics, diagnostic)
+	parser.panicking = true
+}
+
+// recoverableStatement parses a statement, and if it contains a syntax error,
+// skips ahead to the start of the next statement so parsing can continue and
+// later errors can be reported as well. Any statement may be followed by a
+// semicolon.
+func (parser *Parser) recoverableStatement() ast.StatementNode {
+	start := parser.currentToken
+	statement := parser.statement()
+
+	if parser.panicking {
+		parser.synchronize(start)
+	} else if parser.nextTokenIs(token.SEMICOLON) {
+		parser.readToken()
+	}
+
+	return statement
+}
+
+// synchronize discards tokens until the next token begins a new statement:
+// one following a semicolon, or starting a line no further indented than the
+// statement that failed. A closing delimiter only ends the skipped tokens if
+// it is less indented, as it then closes the block the statement was in.
+func (parser *Parser) synchronize(start token.Token) {
+	parser.panicking = false
+
+	for !parser.isAtEnd() && !parser.nextTokenIs(token.EOF) {
+		if parser.currentTokenIs(token.SEMICOLON) {
+			return
+		}
+
+		if parser.nextToken.Line > parser.currentToken.Line {
+			switch parser.nextToken.Type {
+			case token.RIGHTBRACE, token.RIGHTBRACKET, token.RIGHTPAREN:
+				if parser.nextToken.Column < start.Column {
+					return
+				}
+			default:
+				if parser.nextToken.Column <= start.Column {
+					return
+				}
+			}
+		}
+
+		parser.readToken()
+	}
+}
+
+// describe represents a token as it should be referred to in a syntax error.
+func describe(tok token.Token) string {
+	if tok.Type == token.EOF {
+		return "end of file"
+	}
+
+	return fmt.Sprintf("`%s`", tok.Lexeme)
 }
 
 func (parser *Parser) currentTokenIs(tt token.Type) bool {
@@ -229,15 +380,17 @@ func (parser *Parser) expectNextTokenIs(tt token.Type) bool {
 }
 
 func (parser *Parser) nextTokenPrecedence() int {
-	if precedence, ok := precedences[parser.nextToken.Type]; ok {
-		return precedence
-	}
-
-	return LOWEST
+	return Precedence(parser.nextToken.Type)
 }
 
 func (parser *Parser) currentTokenPrecedence() int {
-	if precedence, ok := precedences[parser.currentToken.Type]; ok {
+	return Precedence(parser.currentToken.Type)
+}
+
+// Precedence returns the precedence level of an operator token, or LOWEST if
+// the token is not an operator.
+func Precedence(tokenType token.Type) int {
+	if precedence, ok := precedences[tokenType]; ok {
 		return precedence
 	}
 
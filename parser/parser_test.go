@@ -1,10 +1,13 @@
 package parser
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"ghostlang.org/x/ghost/ast"
 	"ghostlang.org/x/ghost/scanner"
+	"ghostlang.org/x/ghost/token"
 )
 
 func TestAssignStatement(t *testing.T) {
@@ -157,6 +160,74 @@ func TestForInListExpression(t *testing.T) {
 	}
 }
 
+func TestArrowFunctions(t *testing.T) {
+	tests := []struct {
+		input      string
+		parameters []string
+		defaults   int
+	}{
+		{`() => 1`, []string{}, 0},
+		{`x => x * 2`, []string{"x"}, 0},
+		{`(x) => x * 2`, []string{"x"}, 0},
+		{`(a, b) => a + b`, []string{"a", "b"}, 0},
+		{`(a, b = 2) => { return a + b }`, []string{"a", "b"}, 1},
+	}
+
+	for _, tt := range tests {
+		scanner := scanner.New(tt.input, "test.ghost")
+		parser := New(scanner)
+		program := parser.Parse()
+
+		failIfParserHasErrors(t, parser)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statement. got=%d", len(program.Statements))
+		}
+
+		statement, ok := program.Statements[0].(*ast.Expression)
+
+		if !ok {
+			t.Fatalf("program.Statements[0] is not ast.Expression. got=%T", program.Statements[0])
+		}
+
+		function, ok := statement.Expression.(*ast.Function)
+
+		if !ok {
+			t.Fatalf("statement.Expression is not ast.Function. got=%T", statement.Expression)
+		}
+
+		if !function.Arrow {
+			t.Fatalf("function.Arrow is not true for %s", tt.input)
+		}
+
+		if len(function.Parameters) != len(tt.parameters) {
+			t.Fatalf("function.Parameters has wrong length. expected=%d, got=%d", len(tt.parameters), len(function.Parameters))
+		}
+
+		for index, parameter := range tt.parameters {
+			isIdentifier(t, function.Parameters[index], parameter)
+		}
+
+		if len(function.Defaults) != tt.defaults {
+			t.Fatalf("function.Defaults has wrong length. expected=%d, got=%d", tt.defaults, len(function.Defaults))
+		}
+
+		if len(function.Body.Statements) != 1 {
+			t.Fatalf("function.Body does not contain 1 statement. got=%d", len(function.Body.Statements))
+		}
+	}
+}
+
+func TestInvalidArrowFunctionParameters(t *testing.T) {
+	scanner := scanner.New(`(1 + 2) => 3`, "test.ghost")
+	parser := New(scanner)
+	parser.Parse()
+
+	if len(parser.Errors()) != 1 {
+		t.Fatalf("parser should have 1 error. got=%d", len(parser.Errors()))
+	}
+}
+
 func TestIdentifierLiteral(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -316,7 +387,14 @@ func TestInfixExpressions(t *testing.T) {
 		{"5 < 5", 5, "<", 5},
 		{"5 == 5", 5, "==", 5},
 		{"5 != 5", 5, "!=", 5},
-		{"1 .. 10", 1, "..", 10},
+		{"5 ?? 5", 5, "??", 5},
+		{"5 ** 5", 5, "**", 5},
+		{"5 ~/ 5", 5, "~/", 5},
+		{"5 & 5", 5, "&", 5},
+		{"5 | 5", 5, "|", 5},
+		{"5 ^ 5", 5, "^", 5},
+		{"5 << 5", 5, "<<", 5},
+		{"5 >> 5", 5, ">>", 5},
 	}
 
 	for _, tt := range tests {
@@ -454,14 +532,14 @@ func TestPostfixExpressions(t *testing.T) {
 
 		failIfParserHasErrors(t, parser)
 
-		if len(program.Statements) != 2 {
-			t.Fatalf("program.Statements does not contain 2 statements. got=%d", len(program.Statements))
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statement. got=%d", len(program.Statements))
 		}
 
-		statement, ok := program.Statements[1].(*ast.Expression)
+		statement, ok := program.Statements[0].(*ast.Expression)
 
 		if !ok {
-			t.Fatalf("program.Statements[1] is not ast.Expression. got=%T", program.Statements[0])
+			t.Fatalf("program.Statements[0] is not ast.Expression. got=%T", program.Statements[0])
 		}
 
 		postfix, ok := statement.Expression.(*ast.Postfix)
@@ -473,6 +551,41 @@ func TestPostfixExpressions(t *testing.T) {
 		if postfix.Operator != tt.operator {
 			t.Fatalf("postfix.Operator is not '%s'. got=%s", tt.operator, postfix.Operator)
 		}
+
+		if postfix.Token.Lexeme != "index" {
+			t.Fatalf("postfix.Token is not 'index'. got=%s", postfix.Token.Lexeme)
+		}
+	}
+}
+
+func TestPostfixWithinExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"y = x++ + 10", "y = (x++ + 10)"},
+		{"while (i++ < 3) { print(i) }", "while ((i++ < 3)) { print(i) }"},
+		{"total = count-- * 2", "total = (count-- * 2)"},
+		{"print(i++)", "print(i++)"},
+		{"this.count++", "this.count++"},
+		{"total = m.a++ + 1", "total = (m.a++ + 1)"},
+		{"list[0]--", "list[0]--"},
+	}
+
+	for _, tt := range tests {
+		scanner := scanner.New(tt.input, "test.ghost")
+		parser := New(scanner)
+		program := parser.Parse()
+
+		failIfParserHasErrors(t, parser)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("%q does not contain 1 statement. got=%d", tt.input, len(program.Statements))
+		}
+
+		if statement := program.Statements[0].String(); statement != tt.expected {
+			t.Errorf("%q is parsed wrong. expected=%q, got=%q", tt.input, tt.expected, statement)
+		}
 	}
 }
 
@@ -513,6 +626,66 @@ func TestStringLiteral(t *testing.T) {
 	}
 }
 
+func TestInterpolationLiteral(t *testing.T) {
+	input := `"total: ${1 + 2}!"`
+
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	statement, ok := program.Statements[0].(*ast.Expression)
+
+	if !ok {
+		t.Fatalf("program.Statements[0] is not ast.Expression. got=%T", program.Statements[0])
+	}
+
+	interpolation, ok := statement.Expression.(*ast.Interpolation)
+
+	if !ok {
+		t.Fatalf("statement.Expression is not ast.Interpolation. got=%T", statement.Expression)
+	}
+
+	if len(interpolation.Parts) != 3 {
+		t.Fatalf("interpolation.Parts does not contain 3 parts. got=%d", len(interpolation.Parts))
+	}
+
+	isInfixExpression(t, interpolation.Parts[1], 1, "+", 2)
+}
+
+func TestInvalidInterpolationLiteral(t *testing.T) {
+	tests := []string{`"${}"`, `"${1 +}"`, `"${a b}"`}
+
+	for _, input := range tests {
+		scanner := scanner.New(input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Errors()) == 0 {
+			t.Fatalf("parser should have errors for %s", input)
+		}
+	}
+}
+
+func TestUnterminatedInterpolation(t *testing.T) {
+	tests := []string{`x = "abc ${1 + 2"`, `x = "abc ${"`}
+
+	for _, input := range tests {
+		scanner := scanner.New(input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Errors()) != 1 {
+			t.Fatalf("parser should have 1 error for %q. got=%d", input, len(parser.Errors()))
+		}
+
+		if !strings.Contains(parser.Errors()[0], "unterminated interpolation") {
+			t.Errorf("error for %q is wrong. got=%q", input, parser.Errors()[0])
+		}
+	}
+}
+
 func TestListLiteral(t *testing.T) {
 	input := `[1, 4, 6]`
 
@@ -573,6 +746,37 @@ func TestIndexExpressions(t *testing.T) {
 	}
 }
 
+func TestIndexOnNewLine(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"example[0]", []string{"example[0]"}},
+		{"example [0]", []string{"example[0]"}},
+		{"example[\n0\n]", []string{"example[0]"}},
+		{"example\n[0]", []string{"example", "[0]"}},
+		{"example\n[first] = list", []string{"example", "[first] = list"}},
+	}
+
+	for _, tt := range tests {
+		scanner := scanner.New(tt.input, "test.ghost")
+		parser := New(scanner)
+		program := parser.Parse()
+
+		failIfParserHasErrors(t, parser)
+
+		if len(program.Statements) != len(tt.expected) {
+			t.Fatalf("%q does not contain %d statements. got=%d", tt.input, len(tt.expected), len(program.Statements))
+		}
+
+		for index, expected := range tt.expected {
+			if statement := program.Statements[index].String(); statement != expected {
+				t.Errorf("statement %d of %q is wrong. expected=%q, got=%q", index, tt.input, expected, statement)
+			}
+		}
+	}
+}
+
 func TestMapLiteralsWithStringKeys(t *testing.T) {
 	input := `{"one": 1, "two": 2, "three": 3}`
 
@@ -935,90 +1139,1339 @@ func TestTraitExpressions(t *testing.T) {
 	}
 }
 
-// =============================================================================
-// Helper methods
+func TestTryExpressions(t *testing.T) {
+	input := `try {
+		foo()
+	} catch (err) {
+		print(err)
+	} finally {
+		bar()
+	}`
 
-func failIfParserHasErrors(t *testing.T, parser *Parser) {
-	errors := parser.Errors()
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
 
-	if len(errors) == 0 {
+	failIfParserHasErrors(t, parser)
+
+	if len(program.Statements) != 1 {
+		t.Fatalf("program.Statements does not contain 1 statement. got=%d", len(program.Statements))
+	}
+
+	statement, ok := program.Statements[0].(*ast.Expression)
+
+	if !ok {
+		t.Fatalf("program.Statements[0] is not ast.Expression. got=%T", program.Statements[0])
+	}
+
+	expression, ok := statement.Expression.(*ast.Try)
+
+	if !ok {
+		t.Fatalf("statement is not ast.Try. got=%T", statement.Expression)
+	}
+
+	if !isIdentifier(t, expression.Parameter, "err") {
 		return
 	}
 
-	t.Errorf("parser has %d errors", len(errors))
+	if expression.Catch == nil || len(expression.Catch.Statements) != 1 {
+		t.Fatalf("expression.Catch does not contain 1 statement. got=%+v", expression.Catch)
+	}
 
-	for _, message := range errors {
-		t.Errorf("parser error: %q", message)
+	if expression.Finally == nil || len(expression.Finally.Statements) != 1 {
+		t.Fatalf("expression.Finally does not contain 1 statement. got=%+v", expression.Finally)
 	}
+}
 
-	t.FailNow()
+func TestTryWithoutCatchOrFinally(t *testing.T) {
+	scanner := scanner.New(`try { foo() }`, "test.ghost")
+	parser := New(scanner)
+	parser.Parse()
+
+	if len(parser.Errors()) != 1 {
+		t.Fatalf("parser should have 1 error. got=%d", len(parser.Errors()))
+	}
 }
 
-func isIdentifier(t *testing.T, expression ast.ExpressionNode, value string) bool {
-	identifier, ok := expression.(*ast.Identifier)
+func TestListDestructuring(t *testing.T) {
+	scanner := scanner.New(`[first, [second], ...rest] = list`, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	statement, ok := program.Statements[0].(*ast.Assign)
 
 	if !ok {
-		t.Errorf("expression is not ast.Identifier. got=%T", expression)
+		t.Fatalf("program.Statements[0] is not ast.Assign. got=%T", program.Statements[0])
 	}
 
-	if identifier.Value != value {
-		t.Errorf("identifier.Value is not %s. got=%s", value, identifier.Value)
+	pattern, ok := statement.Name.(*ast.ListPattern)
+
+	if !ok {
+		t.Fatalf("statement.Name is not ast.ListPattern. got=%T", statement.Name)
 	}
 
-	return true
+	if len(pattern.Elements) != 2 {
+		t.Fatalf("pattern.Elements does not contain 2 elements. got=%d", len(pattern.Elements))
+	}
+
+	isIdentifier(t, pattern.Elements[0], "first")
+
+	if _, ok := pattern.Elements[1].(*ast.ListPattern); !ok {
+		t.Fatalf("pattern.Elements[1] is not ast.ListPattern. got=%T", pattern.Elements[1])
+	}
+
+	isIdentifier(t, pattern.Rest, "rest")
+	isIdentifier(t, statement.Value, "list")
 }
 
-func isInfixExpression(t *testing.T, expression ast.ExpressionNode, left interface{}, operator string, right interface{}) bool {
-	operatorExpression, ok := expression.(*ast.Infix)
+func TestMapDestructuring(t *testing.T) {
+	scanner := scanner.New(`{name, age: years} = person`, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	statement, ok := program.Statements[0].(*ast.Assign)
 
 	if !ok {
-		t.Errorf("expression is not ast.Infix. got=%T(%s)", expression, expression)
-		return false
+		t.Fatalf("program.Statements[0] is not ast.Assign. got=%T", program.Statements[0])
 	}
 
-	if !isLiteral(t, operatorExpression.Left, left) {
-		return false
-	}
+	pattern, ok := statement.Name.(*ast.MapPattern)
 
-	if operatorExpression.Operator != operator {
-		t.Errorf("expression.Operator is not '%s'. got=%q", operator, operatorExpression.Operator)
-		return false
+	if !ok {
+		t.Fatalf("statement.Name is not ast.MapPattern. got=%T", statement.Name)
 	}
 
-	if !isLiteral(t, operatorExpression.Right, right) {
-		return false
+	expected := map[string]string{"name": "name", "age": "years"}
+
+	if len(pattern.Pairs) != len(expected) {
+		t.Fatalf("pattern.Pairs has wrong length. got=%d", len(pattern.Pairs))
 	}
 
-	return true
+	for key, target := range pattern.Pairs {
+		literal, ok := key.(*ast.String)
+
+		if !ok {
+			t.Fatalf("key is not ast.String. got=%T", key)
+		}
+
+		isIdentifier(t, target, expected[literal.Value])
+	}
 }
 
-func isLiteral(t *testing.T, expression ast.ExpressionNode, expected interface{}) bool {
-	switch value := expected.(type) {
-	case int:
-		return isNumberLiteral(t, expression, int64(value))
-	case int64:
-		return isNumberLiteral(t, expression, int64(value))
-	case float64:
-		return isNumberLiteral(t, expression, int64(value))
-	case string:
-		return isIdentifier(t, expression, value)
+func TestForInDestructuring(t *testing.T) {
+	scanner := scanner.New(`for (key, [a, b] in pairs) { true }`, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	expression, ok := program.Statements[0].(*ast.Expression).Expression.(*ast.ForIn)
+
+	if !ok {
+		t.Fatalf("statement.Expression is not ast.ForIn. got=%T", program.Statements[0].(*ast.Expression).Expression)
 	}
 
-	t.Errorf("type of expression is not a literal. got=%T", expression)
+	isIdentifier(t, expression.Key, "key")
 
-	return false
+	if _, ok := expression.Value.(*ast.ListPattern); !ok {
+		t.Fatalf("expression.Value is not ast.ListPattern. got=%T", expression.Value)
+	}
 }
 
-func isNumberLiteral(t *testing.T, expression ast.ExpressionNode, value int64) bool {
-	number, ok := expression.(*ast.Number)
+func TestInvalidDestructuringTargets(t *testing.T) {
+	tests := []string{
+		`[a, 1] = list`,
+		`[...rest, last] = list`,
+		`{name: "name"} = person`,
+	}
 
-	if !ok {
-		t.Errorf("expression is not ast.Number. got=%T", expression)
+	for _, input := range tests {
+		scanner := scanner.New(input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Errors()) != 1 {
+			t.Fatalf("parser should have 1 error for %q. got=%d", input, len(parser.Errors()))
+		}
 	}
+}
 
-	if number.Value.IntPart() != value {
-		t.Errorf("number.Value is not %d. got=%d", value, number.Value.IntPart())
+func TestRestParameters(t *testing.T) {
+	tests := []struct {
+		input      string
+		parameters int
+		rest       string
+	}{
+		{`function (level, ...messages) { }`, 1, "messages"},
+		{`function (...args) { }`, 0, "args"},
+		{`(first, ...rest) => rest`, 1, "rest"},
+		{`(...rest) => rest`, 0, "rest"},
 	}
 
-	return true
+	for _, tt := range tests {
+		scanner := scanner.New(tt.input, "test.ghost")
+		parser := New(scanner)
+		program := parser.Parse()
+
+		failIfParserHasErrors(t, parser)
+
+		function, ok := program.Statements[0].(*ast.Expression).Expression.(*ast.Function)
+
+		if !ok {
+			t.Fatalf("expression is not ast.Function. got=%T", program.Statements[0].(*ast.Expression).Expression)
+		}
+
+		if len(function.Parameters) != tt.parameters {
+			t.Fatalf("function.Parameters has wrong length. expected=%d, got=%d", tt.parameters, len(function.Parameters))
+		}
+
+		isIdentifier(t, function.Rest, tt.rest)
+	}
+}
+
+func TestInvalidRestParameters(t *testing.T) {
+	tests := []string{
+		`function (...rest, last) { }`,
+		`(...rest, last) => rest`,
+	}
+
+	for _, input := range tests {
+		scanner := scanner.New(input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Errors()) == 0 {
+			t.Fatalf("parser should have errors for %q", input)
+		}
+	}
+}
+
+func TestSpreadExpressions(t *testing.T) {
+	scanner := scanner.New(`f(first, ...rest)`, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	call, ok := program.Statements[0].(*ast.Expression).Expression.(*ast.Call)
+
+	if !ok {
+		t.Fatalf("expression is not ast.Call. got=%T", program.Statements[0].(*ast.Expression).Expression)
+	}
+
+	spread, ok := call.Arguments[1].(*ast.Spread)
+
+	if !ok {
+		t.Fatalf("call.Arguments[1] is not ast.Spread. got=%T", call.Arguments[1])
+	}
+
+	isIdentifier(t, spread.Value, "rest")
+}
+
+func TestMapLiteralsWithSpreads(t *testing.T) {
+	scanner := scanner.New(`{...defaults, "size": 2, ...overrides}`, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	mapLiteral, ok := program.Statements[0].(*ast.Expression).Expression.(*ast.Map)
+
+	if !ok {
+		t.Fatalf("expression is not ast.Map. got=%T", program.Statements[0].(*ast.Expression).Expression)
+	}
+
+	if len(mapLiteral.Keys) != 3 || len(mapLiteral.Pairs) != 1 {
+		t.Fatalf("map has wrong number of keys and pairs. got=%d, %d", len(mapLiteral.Keys), len(mapLiteral.Pairs))
+	}
+
+	for index, name := range map[int]string{0: "defaults", 2: "overrides"} {
+		spread, ok := mapLiteral.Keys[index].(*ast.Spread)
+
+		if !ok {
+			t.Fatalf("mapLiteral.Keys[%d] is not ast.Spread. got=%T", index, mapLiteral.Keys[index])
+		}
+
+		isIdentifier(t, spread.Value, name)
+	}
+}
+
+func TestNamedArguments(t *testing.T) {
+	tests := []struct {
+		input      string
+		positional int
+		named      []string
+	}{
+		{`connect(host: "x", timeout: 5)`, 0, []string{"host", "timeout"}},
+		{`connect("x", timeout: 5)`, 1, []string{"timeout"}},
+		{`connect(ready ? 1 : 2)`, 1, []string{}},
+		{`client.connect("x", timeout: 5)`, 1, []string{"timeout"}},
+	}
+
+	for _, tt := range tests {
+		scanner := scanner.New(tt.input, "test.ghost")
+		parser := New(scanner)
+		program := parser.Parse()
+
+		failIfParserHasErrors(t, parser)
+
+		var arguments []ast.ExpressionNode
+		var named []*ast.NamedArgument
+
+		switch expression := program.Statements[0].(*ast.Expression).Expression.(type) {
+		case *ast.Call:
+			arguments, named = expression.Arguments, expression.Named
+		case *ast.Method:
+			arguments, named = expression.Arguments, expression.Named
+		default:
+			t.Fatalf("expression is not ast.Call or ast.Method. got=%T", expression)
+		}
+
+		if len(arguments) != tt.positional {
+			t.Fatalf("wrong number of positional arguments. expected=%d, got=%d", tt.positional, len(arguments))
+		}
+
+		if len(named) != len(tt.named) {
+			t.Fatalf("wrong number of named arguments. expected=%d, got=%d", len(tt.named), len(named))
+		}
+
+		for index, name := range tt.named {
+			isIdentifier(t, named[index].Name, name)
+		}
+	}
+}
+
+func TestInvalidNamedArguments(t *testing.T) {
+	tests := []string{
+		`connect(host: "x", 5)`,
+		`connect(host: "x", host: "y")`,
+	}
+
+	for _, input := range tests {
+		scanner := scanner.New(input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Errors()) != 1 {
+			t.Fatalf("parser should have 1 error for %q. got=%d", input, len(parser.Errors()))
+		}
+	}
+}
+
+func TestOptionalChaining(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`user?.name`, "*ast.Property"},
+		{`user?.greet()`, "*ast.Method"},
+		{`users?.[0]`, "*ast.Index"},
+	}
+
+	for _, tt := range tests {
+		scanner := scanner.New(tt.input, "test.ghost")
+		parser := New(scanner)
+		program := parser.Parse()
+
+		failIfParserHasErrors(t, parser)
+
+		expression := program.Statements[0].(*ast.Expression).Expression
+		optional := false
+
+		switch expression := expression.(type) {
+		case *ast.Property:
+			optional = expression.Optional
+		case *ast.Method:
+			optional = expression.Optional
+		case *ast.Index:
+			optional = expression.Optional
+		}
+
+		if fmt.Sprintf("%T", expression) != tt.expected {
+			t.Fatalf("expression is not %s. got=%T", tt.expected, expression)
+		}
+
+		if !optional {
+			t.Fatalf("expression is not optional. got=%+v", expression)
+		}
+	}
+}
+
+func TestNullCoalescingPrecedence(t *testing.T) {
+	scanner := scanner.New(`a ?? b or c`, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	infix, ok := program.Statements[0].(*ast.Expression).Expression.(*ast.Infix)
+
+	if !ok || infix.Operator != "??" {
+		t.Fatalf("expression is not a ?? ast.Infix. got=%+v", program.Statements[0].(*ast.Expression).Expression)
+	}
+
+	isIdentifier(t, infix.Left, "a")
+	isInfixExpression(t, infix.Right, "b", "or", "c")
+}
+
+func TestDeclarations(t *testing.T) {
+	tests := []struct {
+		input    string
+		constant bool
+		pattern  bool
+		value    bool
+	}{
+		{`let x = 5`, false, false, true},
+		{`let x`, false, false, false},
+		{`const LIMIT = 10;`, true, false, true},
+		{`let [a, b] = list`, false, true, true},
+		{`const {name} = person`, true, true, true},
+	}
+
+	for _, tt := range tests {
+		scanner := scanner.New(tt.input, "test.ghost")
+		parser := New(scanner)
+		program := parser.Parse()
+
+		failIfParserHasErrors(t, parser)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statement. got=%d", len(program.Statements))
+		}
+
+		declaration, ok := program.Statements[0].(*ast.Declaration)
+
+		if !ok {
+			t.Fatalf("program.Statements[0] is not ast.Declaration. got=%T", program.Statements[0])
+		}
+
+		if declaration.Constant != tt.constant {
+			t.Errorf("declaration.Constant is not %t. got=%t", tt.constant, declaration.Constant)
+		}
+
+		if _, ok := declaration.Name.(*ast.Identifier); ok == tt.pattern {
+			t.Errorf("declaration.Name has wrong type. got=%T", declaration.Name)
+		}
+
+		if (declaration.Value != nil) != tt.value {
+			t.Errorf("declaration.Value presence is not %t. got=%+v", tt.value, declaration.Value)
+		}
+	}
+}
+
+func TestInvalidDeclarations(t *testing.T) {
+	tests := []string{
+		`const LIMIT`,
+		`let [a, b]`,
+		`let 5 = 5`,
+	}
+
+	for _, input := range tests {
+		scanner := scanner.New(input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Errors()) == 0 {
+			t.Fatalf("parser should have errors for %q", input)
+		}
+	}
+}
+
+func TestGeneratorFunctions(t *testing.T) {
+	tests := []struct {
+		input     string
+		generator bool
+	}{
+		{`function () { yield 1 }`, true},
+		{`function () { yield }`, true},
+		{`function () { for (x in list) { yield x * 2 } }`, true},
+		{`function () { return 1 }`, false},
+		{`function () { inner = function () { yield 1 } }`, false},
+		{`() => { yield 1 }`, true},
+		{`(x) => x * 2`, false},
+	}
+
+	for _, tt := range tests {
+		scanner := scanner.New(tt.input, "test.ghost")
+		parser := New(scanner)
+		program := parser.Parse()
+
+		failIfParserHasErrors(t, parser)
+
+		function, ok := program.Statements[0].(*ast.Expression).Expression.(*ast.Function)
+
+		if !ok {
+			t.Fatalf("expression is not ast.Function. got=%T", program.Statements[0].(*ast.Expression).Expression)
+		}
+
+		if function.Generator != tt.generator {
+			t.Fatalf("function.Generator is wrong for %q. expected=%t, got=%t", tt.input, tt.generator, function.Generator)
+		}
+	}
+}
+
+func TestYieldOutsideFunction(t *testing.T) {
+	tests := []string{
+		`yield 1`,
+		`for (x in list) { yield x }`,
+	}
+
+	for _, input := range tests {
+		scanner := scanner.New(input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Errors()) == 0 {
+			t.Fatalf("parser should have errors for %q", input)
+		}
+	}
+}
+
+func TestRangeExpressions(t *testing.T) {
+	tests := []struct {
+		input string
+		start int64
+		end   int64
+		step  int64
+	}{
+		{"1 .. 10", 1, 10, 0},
+		{"10..1", 10, 1, 0},
+		{"1..10 step 2", 1, 10, 2},
+		{"0 .. 100 step 25", 0, 100, 25},
+	}
+
+	for _, tt := range tests {
+		scanner := scanner.New(tt.input, "test.ghost")
+		parser := New(scanner)
+		program := parser.Parse()
+
+		failIfParserHasErrors(t, parser)
+
+		expression, ok := program.Statements[0].(*ast.Expression).Expression.(*ast.Range)
+
+		if !ok {
+			t.Fatalf("expression is not ast.Range. got=%T", program.Statements[0].(*ast.Expression).Expression)
+		}
+
+		isNumberLiteral(t, expression.Start, tt.start)
+		isNumberLiteral(t, expression.End, tt.end)
+
+		if tt.step == 0 {
+			if expression.Step != nil {
+				t.Fatalf("expression.Step is not nil. got=%T", expression.Step)
+			}
+		} else {
+			isNumberLiteral(t, expression.Step, tt.step)
+		}
+	}
+}
+
+func TestStepIdentifierAfterRange(t *testing.T) {
+	input := `numbers = 1..10
+step = 2`
+
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	if len(program.Statements) != 2 {
+		t.Fatalf("program.Statements does not contain 2 statements. got=%d", len(program.Statements))
+	}
+}
+
+func TestMatchExpressions(t *testing.T) {
+	input := `match (value) {
+	case 0, 1 { "binary" }
+	case 2..9 { "digit" }
+	case [first, ...rest] if first > 0 { first }
+	case {"type": "user", name} { name }
+	case User(user) { user }
+	default { null }
+}`
+
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	match, ok := program.Statements[0].(*ast.Expression).Expression.(*ast.Match)
+
+	if !ok {
+		t.Fatalf("expression is not ast.Match. got=%T", program.Statements[0].(*ast.Expression).Expression)
+	}
+
+	isIdentifier(t, match.Value, "value")
+
+	if len(match.Cases) != 6 {
+		t.Fatalf("match.Cases does not contain 6 cases. got=%d", len(match.Cases))
+	}
+
+	if len(match.Cases[0].Patterns) != 2 {
+		t.Fatalf("match.Cases[0] does not contain 2 patterns. got=%d", len(match.Cases[0].Patterns))
+	}
+
+	if _, ok := match.Cases[1].Patterns[0].(*ast.Range); !ok {
+		t.Fatalf("match.Cases[1] pattern is not ast.Range. got=%T", match.Cases[1].Patterns[0])
+	}
+
+	if match.Cases[2].Guard == nil {
+		t.Fatalf("match.Cases[2] does not have a guard")
+	}
+
+	if _, ok := match.Cases[4].Patterns[0].(*ast.Call); !ok {
+		t.Fatalf("match.Cases[4] pattern is not ast.Call. got=%T", match.Cases[4].Patterns[0])
+	}
+
+	if !match.Cases[5].Default {
+		t.Fatalf("match.Cases[5] is not the default case")
+	}
+}
+
+func TestInvalidMatchExpressions(t *testing.T) {
+	tests := []string{
+		`match (x) { case 1 + 2 { 1 } }`,
+		`match (x) { case [...rest, last] { 1 } }`,
+		`match (x) { case {...other} { 1 } }`,
+		`match (x) { case User(a, b) { 1 } }`,
+		`match (x) { default { 1 } default { 2 } }`,
+		`match (x) { 1 { 1 } }`,
+	}
+
+	for _, input := range tests {
+		scanner := scanner.New(input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Errors()) == 0 {
+			t.Fatalf("parser should have errors for %q", input)
+		}
+	}
+}
+
+func TestMatchAsIdentifier(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"match = 1", []string{"match = 1"}},
+		{"for (index, match in matches) { print(match) }", []string{"for (index, match in matches) { print(match) }"}},
+		{"match(text)", []string{"match(text)"}},
+		{"match(text, pattern) + 1", []string{"(match(text, pattern) + 1)"}},
+		{"match.length()", []string{"match.length()"}},
+		{"match(text)\n{first} = other", []string{"match(text)", "{first} = other"}},
+		{"if (match(text)) { 1 }", []string{"if (match(text)) { 1 }"}},
+		{"grade = match (score) { default { 1 } }", []string{"grade = match (score) { default { 1 } }"}},
+	}
+
+	for _, tt := range tests {
+		scanner := scanner.New(tt.input, "test.ghost")
+		parser := New(scanner)
+		program := parser.Parse()
+
+		failIfParserHasErrors(t, parser)
+
+		if len(program.Statements) != len(tt.expected) {
+			t.Fatalf("%q does not contain %d statements. got=%d", tt.input, len(tt.expected), len(program.Statements))
+		}
+
+		for index, expected := range tt.expected {
+			if statement := program.Statements[index].String(); statement != expected {
+				t.Errorf("statement %d of %q is wrong. expected=%q, got=%q", index, tt.input, expected, statement)
+			}
+		}
+	}
+}
+
+func TestEnumStatements(t *testing.T) {
+	input := `enum Status {
+	Active,
+	Banned(reason: "spam", days: 7),
+}`
+
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	enum, ok := program.Statements[0].(*ast.Expression).Expression.(*ast.Enum)
+
+	if !ok {
+		t.Fatalf("expression is not ast.Enum. got=%T", program.Statements[0].(*ast.Expression).Expression)
+	}
+
+	if enum.Name.Value != "Status" {
+		t.Fatalf("enum.Name is not %q. got=%q", "Status", enum.Name.Value)
+	}
+
+	if len(enum.Members) != 2 {
+		t.Fatalf("enum.Members does not contain 2 members. got=%d", len(enum.Members))
+	}
+
+	if enum.Members[0].Name.Value != "Active" || len(enum.Members[0].Values) != 0 {
+		t.Fatalf("enum.Members[0] is wrong. got=%s with %d values", enum.Members[0].Name.Value, len(enum.Members[0].Values))
+	}
+
+	if enum.Members[1].Name.Value != "Banned" || len(enum.Members[1].Values) != 2 {
+		t.Fatalf("enum.Members[1] is wrong. got=%s with %d values", enum.Members[1].Name.Value, len(enum.Members[1].Values))
+	}
+
+	isNumberLiteral(t, enum.Members[1].Values[1].Value, 7)
+}
+
+func TestInvalidEnumStatements(t *testing.T) {
+	tests := []string{
+		`enum { Red }`,
+		`enum Color { Red, Red }`,
+		`enum Color { Red Green }`,
+		`enum Color { Red("#f00") }`,
+	}
+
+	for _, input := range tests {
+		scanner := scanner.New(input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Errors()) == 0 {
+			t.Fatalf("parser should have errors for %q", input)
+		}
+	}
+}
+
+func TestStaticMembers(t *testing.T) {
+	input := `class User {
+	static count = 0
+	static cache
+	static function find(id) { }
+}`
+
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	class := program.Statements[0].(*ast.Expression).Expression.(*ast.Class)
+
+	if len(class.Body.Statements) != 3 {
+		t.Fatalf("class.Body does not contain 3 statements. got=%d", len(class.Body.Statements))
+	}
+
+	tests := []struct {
+		name     string
+		function bool
+	}{
+		{"count", false},
+		{"cache", false},
+		{"find", true},
+	}
+
+	for index, tt := range tests {
+		static, ok := class.Body.Statements[index].(*ast.Static)
+
+		if !ok {
+			t.Fatalf("statement is not ast.Static. got=%T", class.Body.Statements[index])
+		}
+
+		isIdentifier(t, static.Name, tt.name)
+
+		if _, ok := static.Value.(*ast.Function); ok != tt.function {
+			t.Fatalf("static.Value has wrong type for %s. got=%T", tt.name, static.Value)
+		}
+	}
+}
+
+func TestAccessors(t *testing.T) {
+	input := `class Circle {
+	get area() { }
+	set radius(value) { }
+	function get() { }
+}`
+
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	class := program.Statements[0].(*ast.Expression).Expression.(*ast.Class)
+
+	if len(class.Body.Statements) != 3 {
+		t.Fatalf("class.Body does not contain 3 statements. got=%d", len(class.Body.Statements))
+	}
+
+	tests := []struct {
+		name   string
+		setter bool
+	}{
+		{"area", false},
+		{"radius", true},
+	}
+
+	for index, tt := range tests {
+		accessor, ok := class.Body.Statements[index].(*ast.Accessor)
+
+		if !ok {
+			t.Fatalf("statement is not ast.Accessor. got=%T", class.Body.Statements[index])
+		}
+
+		isIdentifier(t, accessor.Function.Name, tt.name)
+
+		if accessor.Setter != tt.setter {
+			t.Fatalf("accessor.Setter for %s is not %t. got=%t", tt.name, tt.setter, accessor.Setter)
+		}
+	}
+
+	if _, ok := class.Body.Statements[2].(*ast.Expression); !ok {
+		t.Fatalf("statement is not ast.Expression. got=%T", class.Body.Statements[2])
+	}
+}
+
+func TestInvalidAccessors(t *testing.T) {
+	tests := []string{
+		`class Circle { get area(x) { } }`,
+		`class Circle { set radius() { } }`,
+		`class Circle { set radius(a, b) { } }`,
+	}
+
+	for _, input := range tests {
+		scanner := scanner.New(input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Errors()) == 0 {
+			t.Fatalf("parser should have errors for %q", input)
+		}
+	}
+}
+
+func TestAbstractMethods(t *testing.T) {
+	input := `trait Shape {
+	abstract function area()
+	abstract function scale(factor, origin)
+}`
+
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	trait := program.Statements[0].(*ast.Expression).Expression.(*ast.Trait)
+
+	if len(trait.Body.Statements) != 2 {
+		t.Fatalf("trait.Body does not contain 2 statements. got=%d", len(trait.Body.Statements))
+	}
+
+	tests := []struct {
+		name       string
+		parameters []string
+	}{
+		{"area", []string{}},
+		{"scale", []string{"factor", "origin"}},
+	}
+
+	for index, tt := range tests {
+		abstract, ok := trait.Body.Statements[index].(*ast.Abstract)
+
+		if !ok {
+			t.Fatalf("statement is not ast.Abstract. got=%T", trait.Body.Statements[index])
+		}
+
+		isIdentifier(t, abstract.Name, tt.name)
+
+		if len(abstract.Parameters) != len(tt.parameters) {
+			t.Fatalf("abstract.Parameters for %s does not contain %d parameters. got=%d", tt.name, len(tt.parameters), len(abstract.Parameters))
+		}
+
+		for i, parameter := range tt.parameters {
+			isIdentifier(t, abstract.Parameters[i], parameter)
+		}
+	}
+}
+
+func TestInvalidAbstractMethods(t *testing.T) {
+	tests := []string{
+		`trait Shape { abstract function area() { } }`,
+		`trait Shape { abstract function () }`,
+	}
+
+	for _, input := range tests {
+		scanner := scanner.New(input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Errors()) == 0 {
+			t.Fatalf("parser should have errors for %q", input)
+		}
+	}
+}
+
+func TestUseExpressions(t *testing.T) {
+	input := `use Comparable, Printable`
+
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	use, ok := program.Statements[0].(*ast.Expression).Expression.(*ast.Use)
+
+	if !ok {
+		t.Fatalf("statement is not ast.Use. got=%T", program.Statements[0].(*ast.Expression).Expression)
+	}
+
+	if len(use.Traits) != 2 {
+		t.Fatalf("use.Traits does not contain 2 traits. got=%d", len(use.Traits))
+	}
+
+	isIdentifier(t, use.Traits[0], "Comparable")
+	isIdentifier(t, use.Traits[1], "Printable")
+}
+
+func TestDecorators(t *testing.T) {
+	input := `@memoize
+@route("/users")
+function users() { }
+
+@entity class User { }`
+
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	if len(program.Statements) != 2 {
+		t.Fatalf("program.Statements does not contain 2 statements. got=%d", len(program.Statements))
+	}
+
+	function, ok := program.Statements[0].(*ast.Expression).Expression.(*ast.Function)
+
+	if !ok {
+		t.Fatalf("statement is not ast.Function. got=%T", program.Statements[0].(*ast.Expression).Expression)
+	}
+
+	if len(function.Decorators) != 2 {
+		t.Fatalf("function.Decorators does not contain 2 decorators. got=%d", len(function.Decorators))
+	}
+
+	isIdentifier(t, function.Decorators[0].Expression, "memoize")
+
+	call, ok := function.Decorators[1].Expression.(*ast.Call)
+
+	if !ok {
+		t.Fatalf("decorator is not ast.Call. got=%T", function.Decorators[1].Expression)
+	}
+
+	isIdentifier(t, call.Callee, "route")
+
+	class, ok := program.Statements[1].(*ast.Expression).Expression.(*ast.Class)
+
+	if !ok {
+		t.Fatalf("statement is not ast.Class. got=%T", program.Statements[1].(*ast.Expression).Expression)
+	}
+
+	if len(class.Decorators) != 1 {
+		t.Fatalf("class.Decorators does not contain 1 decorator. got=%d", len(class.Decorators))
+	}
+
+	isIdentifier(t, class.Decorators[0].Expression, "entity")
+}
+
+func TestInvalidDecorators(t *testing.T) {
+	tests := []string{
+		`@memoize x = 1`,
+		`@memoize 5`,
+	}
+
+	for _, input := range tests {
+		scanner := scanner.New(input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Errors()) == 0 {
+			t.Fatalf("parser should have errors for %q", input)
+		}
+	}
+}
+
+func TestErrorRecovery(t *testing.T) {
+	input := `x = (1 + )
+
+function greet(name) {
+	list = [1, 2
+	return name
+}
+
+class Point {
+	function length() {
+		return = 5
+	}
+}
+
+print(greet("ada")
+z = 10`
+
+	expected := []struct {
+		line     int
+		column   int
+		expected token.Type
+		actual   token.Type
+	}{
+		{1, 10, "", token.RIGHTPAREN},
+		{5, 2, token.RIGHTBRACKET, token.RETURN},
+		{10, 10, "", token.EQUAL},
+		{15, 1, token.RIGHTPAREN, token.IDENTIFIER},
+	}
+
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	parser.Parse()
+
+	diagnostics := parser.Diagnostics()
+
+	if len(diagnostics) != len(expected) {
+		t.Fatalf("parser should have %d errors. got=%d (%v)", len(expected), len(diagnostics), parser.Errors())
+	}
+
+	for index, tt := range expected {
+		diagnostic := diagnostics[index]
+
+		if diagnostic.Line != tt.line || diagnostic.Column != tt.column {
+			t.Errorf("diagnostic %d position is wrong. expected=%d:%d, got=%d:%d", index, tt.line, tt.column, diagnostic.Line, diagnostic.Column)
+		}
+
+		if diagnostic.Expected != tt.expected || diagnostic.Actual != tt.actual {
+			t.Errorf("diagnostic %d tokens are wrong. expected=%q/%q, got=%q/%q", index, tt.expected, tt.actual, diagnostic.Expected, diagnostic.Actual)
+		}
+	}
+}
+
+func TestDiagnosticSnippets(t *testing.T) {
+	tests := []struct {
+		input    string
+		message  string
+		expected string
+	}{
+		{"x = (1 + )", "1:10:test.ghost: syntax error: unexpected `)`", "1 | x = (1 + )\n  |          ^"},
+		{"y = 1\n\tlist = [1, 2\n\treturn list", "3:2:test.ghost: syntax error: expected next token to be `]`, got: `return` instead", "3 | \treturn list\n  | \t^^^^^^"},
+		{`"${1 +}"`, "1:7:test.ghost: syntax error: unexpected end of file", "1 | \"${1 +}\"\n  |       ^"},
+	}
+
+	for _, tt := range tests {
+		scanner := scanner.New(tt.input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Diagnostics()) == 0 {
+			t.Fatalf("parser should have errors for %q", tt.input)
+		}
+
+		diagnostic := parser.Diagnostics()[0]
+
+		if diagnostic.String() != tt.message {
+			t.Errorf("diagnostic message is wrong. expected=%q, got=%q", tt.message, diagnostic.String())
+		}
+
+		if diagnostic.Snippet() != tt.expected {
+			t.Errorf("diagnostic snippet is wrong. expected=%q, got=%q", tt.expected, diagnostic.Snippet())
+		}
+	}
+}
+
+func TestStatementsFollowedBySemicolons(t *testing.T) {
+	input := `print(1); print(2)
+if (ready) { print(3) }; print(4)
+class Point { function x() { return 1 }; static origin = null; }`
+
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	if len(program.Statements) != 5 {
+		t.Fatalf("program.Statements does not contain 5 statements. got=%d", len(program.Statements))
+	}
+}
+
+func TestIncompleteInputReportsErrors(t *testing.T) {
+	tests := []string{
+		`x = `,
+		`x = )`,
+		`import a, b`,
+		`import 5 from "module"`,
+		`function greet( {}`,
+		`function () {`,
+		`if (ready) {`,
+		`for (`,
+	}
+
+	for _, input := range tests {
+		scanner := scanner.New(input, "test.ghost")
+		parser := New(scanner)
+		parser.Parse()
+
+		if len(parser.Errors()) == 0 {
+			t.Errorf("parser should have errors for %q", input)
+		}
+	}
+}
+
+func TestNodeSpans(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected ast.Span
+	}{
+		{"x + 1", span(1, 1, 1, 6)},
+		{"add(1, 2)", span(1, 1, 1, 10)},
+		{"counter++", span(1, 1, 1, 10)},
+		{"(x) => x * 2", span(1, 1, 1, 13)},
+		{`"hello ${name}"`, span(1, 1, 1, 16)},
+		{"let [first, ...rest] = list", span(1, 1, 1, 28)},
+		{"@memoize\nfunction f() {\n\treturn 1\n}", span(1, 1, 4, 2)},
+		{"if (ready) {\n\tgo()\n} else {\n\twait()\n}", span(1, 1, 5, 2)},
+	}
+
+	for _, tt := range tests {
+		scanner := scanner.New(tt.input, "test.ghost")
+		parser := New(scanner)
+		program := parser.Parse()
+
+		failIfParserHasErrors(t, parser)
+
+		if program.Statements[0].Span() != tt.expected {
+			t.Errorf("span of %q is wrong. expected=%+v, got=%+v", tt.input, tt.expected, program.Statements[0].Span())
+		}
+
+		if program.Span() != tt.expected {
+			t.Errorf("program span of %q is wrong. expected=%+v, got=%+v", tt.input, tt.expected, program.Span())
+		}
+	}
+}
+
+func TestNodeStrings(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"x = 1 + 2 * 3", "x = (1 + (2 * 3))"},
+		{"counter++", "counter++"},
+		{"total -= 2", "total -= 2"},
+		{"square = x => x * x", "square = (x) => (x * x)"},
+		{"user?.profile?.name ?? 'anon'", `(user?.profile?.name ?? "anon")`},
+		{`print('say "hi"', "cost: \\${price}")`, `print('say "hi"', "cost: \\${price}")`},
+		{"function add(a, b = 1) {\n\treturn a + b\n}", "function add(a, b = 1) { return (a + b) }"},
+		{"const {name, age: years} = person", "const {name, age: years} = person"},
+		{"for (i in 1..10 step 2) {\n\tprint(i)\n}", "for (i in 1..10 step 2) { print(i) }"},
+		{"import a, b as c from \"mod\"", `import a, b as c from "mod"`},
+		{"@entity\nclass User extends Model {\n\tstatic count = 0\n}", "@entity class User extends Model { static count = 0 }"},
+		{"result = match (x) {\n\tcase 0, 1 { \"small\" }\n\tdefault { \"big\" }\n}", `result = match (x) { case 0, 1 { "small" } default { "big" } }`},
+	}
+
+	for _, tt := range tests {
+		parser := New(scanner.New(tt.input, "test.ghost"))
+		program := parser.Parse()
+
+		failIfParserHasErrors(t, parser)
+
+		if program.String() != tt.expected {
+			t.Errorf("rendering of %q is wrong. expected=%q, got=%q", tt.input, tt.expected, program.String())
+		}
+
+		// The rendered source must parse back to the same tree
+		reparser := New(scanner.New(program.String(), "test.ghost"))
+		reparsed := reparser.Parse()
+
+		failIfParserHasErrors(t, reparser)
+
+		if reparsed.String() != program.String() {
+			t.Errorf("rendering of %q does not round trip. expected=%q, got=%q", tt.input, program.String(), reparsed.String())
+		}
+	}
+}
+
+func TestInspect(t *testing.T) {
+	input := `total = add(x, y * scale(z))`
+
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	identifiers := []string{}
+
+	ast.Inspect(program, func(node ast.Node) bool {
+		if identifier, ok := node.(*ast.Identifier); ok {
+			identifiers = append(identifiers, identifier.Value)
+		}
+
+		// Skip the arguments of nested calls
+		if call, ok := node.(*ast.Call); ok && call.Callee.String() == "scale" {
+			return false
+		}
+
+		return true
+	})
+
+	expected := []string{"total", "add", "x", "y"}
+
+	if fmt.Sprint(identifiers) != fmt.Sprint(expected) {
+		t.Errorf("inspected identifiers are wrong. expected=%v, got=%v", expected, identifiers)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	input := `if (ready) {
+	while (true) {
+		print(1)
+	}
+}`
+
+	scanner := scanner.New(input, "test.ghost")
+	parser := New(scanner)
+	program := parser.Parse()
+
+	failIfParserHasErrors(t, parser)
+
+	visitor := &depthVisitor{}
+
+	ast.Walk(visitor, program)
+
+	if visitor.depth != 0 {
+		t.Errorf("walk did not leave every node it entered. depth=%d", visitor.depth)
+	}
+
+	// Program, Expression, If, Block, Expression, While, Block, Expression,
+	// Call, Number
+	if visitor.deepest != 10 {
+		t.Errorf("walk did not reach the innermost node. expected depth=10, got=%d", visitor.deepest)
+	}
+}
+
+// =============================================================================
+// Helper methods
+
+func failIfParserHasErrors(t *testing.T, parser *Parser) {
+	errors := parser.Errors()
+
+	if len(errors) == 0 {
+		return
+	}
+
+	t.Errorf("parser has %d errors", len(errors))
+
+	for _, message := range errors {
+		t.Errorf("parser error: %q", message)
+	}
+
+	t.FailNow()
+}
+
+func isIdentifier(t *testing.T, expression ast.ExpressionNode, value string) bool {
+	identifier, ok := expression.(*ast.Identifier)
+
+	if !ok {
+		t.Errorf("expression is not ast.Identifier. got=%T", expression)
+	}
+
+	if identifier.Value != value {
+		t.Errorf("identifier.Value is not %s. got=%s", value, identifier.Value)
+	}
+
+	return true
+}
+
+func isInfixExpression(t *testing.T, expression ast.ExpressionNode, left interface{}, operator string, right interface{}) bool {
+	operatorExpression, ok := expression.(*ast.Infix)
+
+	if !ok {
+		t.Errorf("expression is not ast.Infix. got=%T(%s)", expression, expression)
+		return false
+	}
+
+	if !isLiteral(t, operatorExpression.Left, left) {
+		return false
+	}
+
+	if operatorExpression.Operator != operator {
+		t.Errorf("expression.Operator is not '%s'. got=%q", operator, operatorExpression.Operator)
+		return false
+	}
+
+	if !isLiteral(t, operatorExpression.Right, right) {
+		return false
+	}
+
+	return true
+}
+
+func isLiteral(t *testing.T, expression ast.ExpressionNode, expected interface{}) bool {
+	switch value := expected.(type) {
+	case int:
+		return isNumberLiteral(t, expression, int64(value))
+	case int64:
+		return isNumberLiteral(t, expression, int64(value))
+	case float64:
+		return isNumberLiteral(t, expression, int64(value))
+	case string:
+		return isIdentifier(t, expression, value)
+	}
+
+	t.Errorf("type of expression is not a literal. got=%T", expression)
+
+	return false
+}
+
+func isNumberLiteral(t *testing.T, expression ast.ExpressionNode, value int64) bool {
+	number, ok := expression.(*ast.Number)
+
+	if !ok {
+		t.Errorf("expression is not ast.Number. got=%T", expression)
+	}
+
+	if number.Value.IntPart() != value {
+		t.Errorf("number.Value is not %d. got=%d", value, number.Value.IntPart())
+	}
+
+	return true
+}
+
+func span(startLine int, startColumn int, endLine int, endColumn int) ast.Span {
+	return ast.Span{
+		Start: ast.Position{Line: startLine, Column: startColumn},
+		End:   ast.Position{Line: endLine, Column: endColumn},
+	}
+}
+
+// depthVisitor tracks how deeply nested the node being visited is.
+type depthVisitor struct {
+	depth   int
+	deepest int
+}
+
+func (visitor *depthVisitor) Visit(node ast.Node) ast.Visitor {
+	if node == nil {
+		visitor.depth--
+
+		return nil
+	}
+
+	visitor.depth++
+
+	if visitor.depth > visitor.deepest {
+		visitor.deepest = visitor.depth
+	}
+
+	return visitor
 }
@@ -5,6 +5,7 @@ import "ghostlang.org/x/ghost/ast"
 func (parser *Parser) postfixExpression() ast.ExpressionNode {
 	return &ast.Postfix{
 		Token:    parser.previousToken,
+		Left:     &ast.Identifier{Token: parser.previousToken, Value: parser.previousToken.Lexeme},
 		Operator: parser.currentToken.Lexeme,
 	}
 }
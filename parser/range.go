@@ -0,0 +1,26 @@
+package parser
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/token"
+)
+
+// rangeExpression parses a range from the left expression to the right one,
+// optionally followed by the step to count by on the same line (1..10 step 2).
+// The step keyword is contextual, so "step" remains usable as an identifier.
+func (parser *Parser) rangeExpression(left ast.ExpressionNode) ast.ExpressionNode {
+	expression := &ast.Range{Token: parser.currentToken, Start: left}
+
+	parser.readToken()
+
+	expression.End = parser.parseExpression(RANGE)
+
+	if parser.nextTokenIs(token.IDENTIFIER) && parser.nextToken.Lexeme == "step" && parser.nextToken.Line == parser.currentToken.Line {
+		parser.readToken()
+		parser.readToken()
+
+		expression.Step = parser.parseExpression(RANGE)
+	}
+
+	return expression
+}
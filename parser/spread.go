@@ -0,0 +1,13 @@
+package parser
+
+import "ghostlang.org/x/ghost/ast"
+
+func (parser *Parser) spreadExpression() ast.ExpressionNode {
+	spread := &ast.Spread{Token: parser.currentToken}
+
+	parser.readToken()
+
+	spread.Value = parser.parseExpression(LOWEST)
+
+	return spread
+}
@@ -9,6 +9,20 @@ func (parser *Parser) statement() ast.StatementNode {
 	switch parser.currentToken.Type {
 	case token.RETURN:
 		return parser.returnStatement()
+	case token.LEFTBRACKET, token.LEFTBRACE:
+		return parser.destructure()
+	case token.LET, token.CONST:
+		return parser.declarationStatement()
+	case token.STATIC:
+		return parser.staticStatement()
+	case token.IDENTIFIER:
+		if (parser.currentToken.Lexeme == "get" || parser.currentToken.Lexeme == "set") && parser.nextTokenIs(token.IDENTIFIER) {
+			return parser.accessorStatement()
+		}
+
+		if parser.currentToken.Lexeme == "abstract" && parser.nextTokenIs(token.FUNCTION) {
+			return parser.abstractStatement()
+		}
 	}
 
 	statement := parser.assign()
@@ -24,5 +38,16 @@ func (parser *Parser) expressionStatement() ast.StatementNode {
 	statement := &ast.Expression{}
 	statement.Expression = parser.parseExpression(LOWEST)
 
+	// foo.bar = true and foo["bar"] = true are assignments, so the target
+	// should not also be evaluated as an expression of its own.
+	switch statement.Expression.(type) {
+	case *ast.Property, *ast.Index:
+		if parser.nextTokenIs(token.EQUAL) {
+			parser.readToken()
+
+			return parser.assign()
+		}
+	}
+
 	return statement
 }
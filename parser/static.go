@@ -0,0 +1,52 @@
+package parser
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/token"
+)
+
+// staticStatement parses a static member of a class, either a static method
+// or a static property with an optional initial value.
+func (parser *Parser) staticStatement() ast.StatementNode {
+	statement := &ast.Static{Token: parser.currentToken}
+
+	if parser.nextTokenIs(token.FUNCTION) {
+		parser.readToken()
+
+		function, ok := parser.functionStatement().(*ast.Function)
+
+		if !ok {
+			return nil
+		}
+
+		if function.Name == nil {
+			parser.syntaxError(statement.Token, "static methods must be named")
+
+			return nil
+		}
+
+		statement.Name = function.Name
+		statement.Value = function
+
+		return statement
+	}
+
+	if !parser.expectNextTokenIs(token.IDENTIFIER) {
+		return nil
+	}
+
+	statement.Name = &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
+
+	if !parser.nextTokenIs(token.EQUAL) {
+		statement.Value = &ast.Null{Token: parser.currentToken}
+
+		return statement
+	}
+
+	parser.readToken()
+	parser.readToken()
+
+	statement.Value = parser.parseExpression(LOWEST)
+
+	return statement
+}
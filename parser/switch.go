@@ -84,6 +84,8 @@ func (parser *Parser) switchStatement() ast.ExpressionNode {
 		return nil
 	}
 
+	expression.Closing = parser.currentToken
+
 	// Check for multiple default cases
 	defaultCount := 0
 
@@ -94,7 +96,7 @@ func (parser *Parser) switchStatement() ast.ExpressionNode {
 	}
 
 	if defaultCount > 1 {
-		parser.errors = append(parser.errors, "multiple default cases in switch statement")
+		parser.syntaxError(expression.Token, "multiple default cases in switch statement")
 		return nil
 	}
 
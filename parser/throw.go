@@ -0,0 +1,13 @@
+package parser
+
+import "ghostlang.org/x/ghost/ast"
+
+func (parser *Parser) throwExpression() ast.ExpressionNode {
+	expression := &ast.Throw{Token: parser.currentToken}
+
+	parser.readToken()
+
+	expression.Value = parser.parseExpression(LOWEST)
+
+	return expression
+}
@@ -0,0 +1,59 @@
+package parser
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/token"
+)
+
+func (parser *Parser) tryExpression() ast.ExpressionNode {
+	expression := &ast.Try{Token: parser.currentToken}
+
+	if !parser.expectNextTokenIs(token.LEFTBRACE) {
+		return nil
+	}
+
+	expression.Body = parser.blockStatement()
+
+	if parser.nextTokenIs(token.CATCH) {
+		parser.readToken()
+
+		// The caught error may optionally be bound to an identifier
+		if parser.nextTokenIs(token.LEFTPAREN) {
+			parser.readToken()
+
+			if !parser.expectNextTokenIs(token.IDENTIFIER) {
+				return nil
+			}
+
+			expression.Parameter = &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
+
+			if !parser.expectNextTokenIs(token.RIGHTPAREN) {
+				return nil
+			}
+		}
+
+		if !parser.expectNextTokenIs(token.LEFTBRACE) {
+			return nil
+		}
+
+		expression.Catch = parser.blockStatement()
+	}
+
+	if parser.nextTokenIs(token.FINALLY) {
+		parser.readToken()
+
+		if !parser.expectNextTokenIs(token.LEFTBRACE) {
+			return nil
+		}
+
+		expression.Finally = parser.blockStatement()
+	}
+
+	if expression.Catch == nil && expression.Finally == nil {
+		parser.syntaxError(expression.Token, "expected `catch` or `finally` after try block")
+
+		return nil
+	}
+
+	return expression
+}
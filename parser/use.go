@@ -12,9 +12,18 @@ func (parser *Parser) useExpression() ast.ExpressionNode {
 		return nil
 	}
 
-	identifier := &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme}
+	use.Traits = append(use.Traits, &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme})
 
-	use.Traits = append(use.Traits, identifier)
+	// use Comparable, Printable
+	for parser.nextTokenIs(token.COMMA) {
+		parser.readToken()
+
+		if !parser.expectNextTokenIs(token.IDENTIFIER) {
+			return nil
+		}
+
+		use.Traits = append(use.Traits, &ast.Identifier{Token: parser.currentToken, Value: parser.currentToken.Lexeme})
+	}
 
 	return use
 }
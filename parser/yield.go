@@ -0,0 +1,47 @@
+package parser
+
+import (
+	"ghostlang.org/x/ghost/ast"
+	"ghostlang.org/x/ghost/token"
+)
+
+// yieldExpression parses a yield expression, which marks the enclosing
+// function as a generator. The yielded value may be omitted, in which case
+// null is yielded.
+func (parser *Parser) yieldExpression() ast.ExpressionNode {
+	expression := &ast.Yield{Token: parser.currentToken}
+
+	if len(parser.generators) == 0 {
+		parser.syntaxError(parser.currentToken, "yield outside of a function")
+
+		return nil
+	}
+
+	parser.generators[len(parser.generators)-1] = true
+
+	switch parser.nextToken.Type {
+	case token.SEMICOLON, token.RIGHTBRACE, token.RIGHTPAREN, token.COMMA, token.EOF:
+		expression.Value = &ast.Null{Token: parser.currentToken}
+	default:
+		parser.readToken()
+
+		expression.Value = parser.parseExpression(LOWEST)
+	}
+
+	return expression
+}
+
+// enterFunctionBody marks the start of a function body, so yield expressions
+// within it can be attributed to the function.
+func (parser *Parser) enterFunctionBody() {
+	parser.generators = append(parser.generators, false)
+}
+
+// leaveFunctionBody marks the end of a function body, and reports whether the
+// body contained a yield expression.
+func (parser *Parser) leaveFunctionBody() bool {
+	generator := parser.generators[len(parser.generators)-1]
+	parser.generators = parser.generators[:len(parser.generators)-1]
+
+	return generator
+}
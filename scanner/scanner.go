@@ -2,19 +2,25 @@ package scanner
 
 import (
 	"fmt"
+	"strings"
 
 	"ghostlang.org/x/ghost/token"
 )
 
 // Scanner transforms our source code into tokens.
 type Scanner struct {
-	source       []rune // raw source to be scanned
-	file         string // file that contains the source being scanned
-	character    rune   // current character being scanned
-	position     int    // current position in source (pointing to current character)
-	readPosition int    // current reading position in source (point to next character)
-	line         int    // current line being scanned
-	column       int    // current column being scanned
+	source        []rune        // raw source to be scanned
+	file          string        // file that contains the source being scanned
+	character     rune          // current character being scanned
+	position      int           // current position in source (pointing to current character)
+	readPosition  int           // current reading position in source (point to next character)
+	line          int           // current line being scanned
+	firstLine     int           // line of file the source begins on
+	startPosition int           // position in source the current token begins at
+	startLine     int           // line the current token begins on
+	startColumn   int           // column the current token begins on
+	column        int           // current column being scanned
+	comments      []token.Token // comments skipped over so far
 }
 
 // keywords contains a list of all reserved keywords.
@@ -23,33 +29,48 @@ var keywords = map[string]token.Type{
 	"as":       token.AS,
 	"break":    token.BREAK,
 	"case":     token.CASE,
+	"catch":    token.CATCH,
 	"class":    token.CLASS,
+	"const":    token.CONST,
 	"continue": token.CONTINUE,
 	"default":  token.DEFAULT,
 	"else":     token.ELSE,
+	"enum":     token.ENUM,
 	"extends":  token.EXTENDS,
 	"false":    token.FALSE,
+	"finally":  token.FINALLY,
 	"for":      token.FOR,
 	"from":     token.FROM,
 	"function": token.FUNCTION,
 	"if":       token.IF,
 	"import":   token.IMPORT,
 	"in":       token.IN,
+	"let":      token.LET,
 	"null":     token.NULL,
 	"or":       token.OR,
 	"return":   token.RETURN,
+	"static":   token.STATIC,
 	"super":    token.SUPER,
 	"switch":   token.SWITCH,
 	"this":     token.THIS,
+	"throw":    token.THROW,
 	"trait":    token.TRAIT,
 	"true":     token.TRUE,
+	"try":      token.TRY,
 	"use":      token.USE,
 	"while":    token.WHILE,
+	"yield":    token.YIELD,
 }
 
 // New creates a new scanner instance.
 func New(source string, file string) *Scanner {
-	scanner := Scanner{source: []rune(source), file: file, line: 1, column: 1}
+	return NewAt(source, file, 1, 1)
+}
+
+// NewAt creates a new scanner instance for source that begins at the given
+// line and column of file, such as an interpolated string expression.
+func NewAt(source string, file string, line int, column int) *Scanner {
+	scanner := Scanner{source: []rune(source), file: file, line: line, firstLine: line, column: column}
 
 	scanner.readCharacter()
 
@@ -70,13 +91,50 @@ func (scanner *Scanner) readCharacter() {
 	scanner.column++
 }
 
+// Line returns the source of the given line of file, without its line break.
+// Lines outside of the scanned source are empty.
+func (scanner *Scanner) Line(number int) string {
+	lines := strings.Split(string(scanner.source), "\n")
+	index := number - scanner.firstLine
+
+	if index < 0 || index >= len(lines) {
+		return ""
+	}
+
+	return strings.TrimRight(lines[index], "\r")
+}
+
+// Comments returns the comments skipped over so far, in the order they appear
+// in the source. They never reach the parser, but tools printing the source
+// back out, such as the formatter, need to keep them.
+func (scanner *Scanner) Comments() []token.Token {
+	return scanner.comments
+}
+
+// ScanToken scans the next token, recording where in the source it begins
+// and ends.
+func (scanner *Scanner) ScanToken() token.Token {
+	scannedToken := scanner.scanToken()
+
+	scannedToken.Line = scanner.startLine
+	scannedToken.Column = scanner.startColumn
+	scannedToken.EndLine = scanner.line
+	scannedToken.EndColumn = scanner.column - 1
+
+	return scannedToken
+}
+
 // scanToken is responsible for scanning the current character and storing the
 // correct token type for it. This is the heart of our scanner.
-func (scanner *Scanner) ScanToken() token.Token {
+func (scanner *Scanner) scanToken() token.Token {
 	var scannedToken token.Token
 
 	scanner.skipWhitespace()
 
+	scanner.startPosition = scanner.position
+	scanner.startLine = scanner.line
+	scanner.startColumn = scanner.column - 1
+
 	switch scanner.character {
 	case rune('('):
 		scannedToken = scanner.newToken(token.LEFTPAREN, "(", 1)
@@ -94,7 +152,11 @@ func (scanner *Scanner) ScanToken() token.Token {
 		scannedToken = scanner.newToken(token.COMMA, ",", 1)
 	case rune('.'):
 		if scanner.match('.') {
-			scannedToken = scanner.newToken(token.DOTDOT, "..", 2)
+			if scanner.match('.') {
+				scannedToken = scanner.newToken(token.ELLIPSIS, "...", 3)
+			} else {
+				scannedToken = scanner.newToken(token.DOTDOT, "..", 2)
+			}
 		} else {
 			scannedToken = scanner.newToken(token.DOT, ".", 1)
 		}
@@ -119,13 +181,60 @@ func (scanner *Scanner) ScanToken() token.Token {
 	case rune('*'):
 		if scanner.match('=') {
 			scannedToken = scanner.newToken(token.STAREQUAL, "*=", 2)
+		} else if scanner.match('*') {
+			if scanner.match('=') {
+				scannedToken = scanner.newToken(token.STARSTAREQUAL, "**=", 3)
+			} else {
+				scannedToken = scanner.newToken(token.STARSTAR, "**", 2)
+			}
 		} else {
 			scannedToken = scanner.newToken(token.STAR, "*", 1)
 		}
 	case rune('%'):
-		scannedToken = scanner.newToken(token.PERCENT, "%", 1)
+		if scanner.match('=') {
+			scannedToken = scanner.newToken(token.PERCENTEQUAL, "%=", 2)
+		} else {
+			scannedToken = scanner.newToken(token.PERCENT, "%", 1)
+		}
+	case rune('&'):
+		if scanner.match('=') {
+			scannedToken = scanner.newToken(token.AMPERSANDEQUAL, "&=", 2)
+		} else {
+			scannedToken = scanner.newToken(token.AMPERSAND, "&", 1)
+		}
+	case rune('|'):
+		if scanner.match('=') {
+			scannedToken = scanner.newToken(token.PIPEEQUAL, "|=", 2)
+		} else {
+			scannedToken = scanner.newToken(token.PIPE, "|", 1)
+		}
+	case rune('^'):
+		if scanner.match('=') {
+			scannedToken = scanner.newToken(token.CARETEQUAL, "^=", 2)
+		} else {
+			scannedToken = scanner.newToken(token.CARET, "^", 1)
+		}
+	case rune('~'):
+		// "//" begins a comment, so integer division is written "~/"
+		if scanner.match('/') {
+			if scanner.match('=') {
+				scannedToken = scanner.newToken(token.TILDESLASHEQUAL, "~/=", 3)
+			} else {
+				scannedToken = scanner.newToken(token.TILDESLASH, "~/", 2)
+			}
+		} else {
+			scannedToken = scanner.newToken(token.TILDE, "~", 1)
+		}
 	case rune('?'):
-		scannedToken = scanner.newToken(token.QUESTION, "?", 1)
+		if scanner.match('.') {
+			scannedToken = scanner.newToken(token.QUESTIONDOT, "?.", 2)
+		} else if scanner.match('?') {
+			scannedToken = scanner.newToken(token.QUESTIONQUESTION, "??", 2)
+		} else {
+			scannedToken = scanner.newToken(token.QUESTION, "?", 1)
+		}
+	case rune('@'):
+		scannedToken = scanner.newToken(token.AT, "@", 1)
 	case rune(':'):
 		scannedToken = scanner.newToken(token.COLON, ":", 1)
 	case rune('!'):
@@ -137,22 +246,43 @@ func (scanner *Scanner) ScanToken() token.Token {
 	case rune('='):
 		if scanner.match('=') {
 			scannedToken = scanner.newToken(token.EQUALEQUAL, "==", 2)
+		} else if scanner.match('>') {
+			scannedToken = scanner.newToken(token.ARROW, "=>", 2)
 		} else {
 			scannedToken = scanner.newToken(token.EQUAL, "=", 1)
 		}
 	case rune('<'):
 		if scanner.match('=') {
 			scannedToken = scanner.newToken(token.LESSEQUAL, "<=", 2)
+		} else if scanner.match('<') {
+			if scanner.match('=') {
+				scannedToken = scanner.newToken(token.LESSLESSEQUAL, "<<=", 3)
+			} else {
+				scannedToken = scanner.newToken(token.LESSLESS, "<<", 2)
+			}
 		} else {
 			scannedToken = scanner.newToken(token.LESS, "<", 1)
 		}
 	case rune('>'):
 		if scanner.match('=') {
 			scannedToken = scanner.newToken(token.GREATEREQUAL, ">=", 2)
+		} else if scanner.match('>') {
+			if scanner.match('=') {
+				scannedToken = scanner.newToken(token.GREATERGREATEREQUAL, ">>=", 3)
+			} else {
+				scannedToken = scanner.newToken(token.GREATERGREATER, ">>", 2)
+			}
 		} else {
 			scannedToken = scanner.newToken(token.GREATER, ">", 1)
 		}
 	case rune('#'):
+		// Private property names directly follow a dot, as in this.#secret
+		if scanner.position > 0 && scanner.source[scanner.position-1] == rune('.') {
+			identifier := scanner.scanIdentifier()
+
+			return scanner.newToken(token.IDENTIFIER, identifier, len(identifier)+1)
+		}
+
 		scanner.skipSingleLineComment()
 
 		return scanner.ScanToken()
@@ -171,9 +301,14 @@ func (scanner *Scanner) ScanToken() token.Token {
 			scannedToken = scanner.newToken(token.SLASH, "/", 1)
 		}
 	case rune('"'):
-		value := scanner.scanString('"')
+		value, fragments := scanner.scanInterpolatedString()
 
-		scannedToken = scanner.newToken(token.STRING, value, len(value))
+		if len(fragments) == 1 && !fragments[0].Expression {
+			scannedToken = scanner.newToken(token.STRING, fragments[0].Value, len(value))
+		} else {
+			scannedToken = scanner.newToken(token.INTERPOLATION, value, len(value))
+			scannedToken.Literal = fragments
+		}
 	case rune('\''):
 		value := scanner.scanString('\'')
 
@@ -209,11 +344,109 @@ func (scanner *Scanner) scanString(closing rune) string {
 		if scanner.character == closing || scanner.isAtEnd() {
 			break
 		}
+
+		if scanner.character == rune('\n') {
+			scanner.advanceLine()
+		}
 	}
 
 	return string(scanner.source[position:scanner.position])
 }
 
+// scanInterpolatedString consumes characters until it hits either the closing
+// " or end of file, splitting the string into literal and ${...} expression
+// fragments along the way. An escaped \${ is kept as literal text.
+func (scanner *Scanner) scanInterpolatedString() (string, []token.Fragment) {
+	position := scanner.position + 1
+	fragments := []token.Fragment{}
+
+	var literal strings.Builder
+
+	for {
+		scanner.readCharacter()
+
+		if scanner.character == rune('"') || scanner.isAtEnd() {
+			break
+		}
+
+		if scanner.character == rune('\n') {
+			scanner.advanceLine()
+		}
+
+		if scanner.character == rune('\\') && scanner.peekCharacter() == rune('$') && scanner.peekCharacterAfter() == rune('{') {
+			// Consume the "\" and keep the "${" as literal text
+			scanner.readCharacter()
+			literal.WriteRune(scanner.character)
+
+			continue
+		}
+
+		if scanner.character == rune('$') && scanner.peekCharacter() == rune('{') {
+			if literal.Len() > 0 {
+				fragments = append(fragments, token.Fragment{Value: literal.String()})
+				literal.Reset()
+			}
+
+			fragments = append(fragments, scanner.scanInterpolatedExpression())
+
+			if scanner.isAtEnd() {
+				break
+			}
+
+			continue
+		}
+
+		literal.WriteRune(scanner.character)
+	}
+
+	if literal.Len() > 0 || len(fragments) == 0 {
+		fragments = append(fragments, token.Fragment{Value: literal.String()})
+	}
+
+	return string(scanner.source[position:min(scanner.position, len(scanner.source))]), fragments
+}
+
+// scanInterpolatedExpression consumes the source of a ${...} expression up
+// until its matching closing brace, skipping over any nested braces and
+// strings the expression may contain. If the file ends first, the fragment is
+// marked as unterminated.
+func (scanner *Scanner) scanInterpolatedExpression() token.Fragment {
+	// Consume the "$" and "{"
+	scanner.readCharacter()
+	scanner.readCharacter()
+
+	position := scanner.position
+	fragment := token.Fragment{Expression: true, Line: scanner.line, Column: scanner.column - 1}
+	depth := 0
+
+	for !scanner.isAtEnd() {
+		switch scanner.character {
+		case rune('\n'):
+			scanner.advanceLine()
+		case rune('{'):
+			depth++
+		case rune('}'):
+			depth--
+		case rune('"'):
+			scanner.scanInterpolatedString()
+		case rune('\''):
+			scanner.scanString('\'')
+		}
+
+		// A nested string may have run to the end of the file
+		if depth < 0 || scanner.isAtEnd() {
+			break
+		}
+
+		scanner.readCharacter()
+	}
+
+	fragment.Value = string(scanner.source[position:min(scanner.position, len(scanner.source))])
+	fragment.Unterminated = scanner.isAtEnd()
+
+	return fragment
+}
+
 // scanNumber consumes all digits for the integer part of the literal, and then
 // the fractional part if we encounter a decimal point (.) followed by at least
 // one digit. If we do have a fractional part, we consume all remaining digits.
@@ -279,42 +512,57 @@ func (scanner *Scanner) newToken(tokenType token.Type, literal interface{}, leng
 }
 
 // skipSingleLineComment consumes and reads characters until it reaches the end
-// of the line. Comments are lexemes but they aren't meaningful, so we simply
-// discard them here.
+// of the line. Comments are lexemes but they aren't meaningful to the parser,
+// so they are set aside rather than returned as tokens.
 func (scanner *Scanner) skipSingleLineComment() {
 	for scanner.character != '\n' && !scanner.isAtEnd() {
 		scanner.readCharacter()
 	}
 
+	scanner.addComment()
 	scanner.skipWhitespace()
 }
 
 // skipMultiLineComment consumes and reads characters until it reaches either
 // the end of our source or the closing comment delimiter (*/). Comments are
-// lexemes but they aren't meaningful, so we simply discard them here.
+// lexemes but they aren't meaningful to the parser, so they are set aside
+// rather than returned as tokens.
 func (scanner *Scanner) skipMultiLineComment() {
-	endOfComment := false
+	// Consume the opening "*", so it can't also close the comment
+	scanner.readCharacter()
 
-	for !endOfComment {
+	for !scanner.isAtEnd() {
 		if scanner.character == rune('\n') {
 			scanner.advanceLine()
 		}
 
-		if scanner.isAtEnd() {
-			endOfComment = true
-		}
-
 		if scanner.character == rune('*') && scanner.match('/') {
-			endOfComment = true
 			scanner.readCharacter()
+
+			break
 		}
 
 		scanner.readCharacter()
 	}
 
+	scanner.addComment()
 	scanner.skipWhitespace()
 }
 
+// addComment records the comment scanned as the current token, which ends
+// just before the current character.
+func (scanner *Scanner) addComment() {
+	scanner.comments = append(scanner.comments, token.Token{
+		Type:      token.COMMENT,
+		Lexeme:    string(scanner.source[scanner.startPosition:min(scanner.position, len(scanner.source))]),
+		Line:      scanner.startLine,
+		Column:    scanner.startColumn,
+		File:      scanner.file,
+		EndLine:   scanner.line,
+		EndColumn: scanner.column - 1,
+	})
+}
+
 // skipWhitespace consumes and reads whitespace characters.
 func (scanner *Scanner) skipWhitespace() {
 	for isWhitespace(scanner.character) {
@@ -349,7 +597,8 @@ func isWhitespace(character rune) bool {
 
 // isOperator tells us if the passed character is an operator.
 func isOperator(character rune) bool {
-	return character == rune('+') || character == rune('-') || character == rune('*') || character == rune('/') || character == rune('%')
+	return character == rune('+') || character == rune('-') || character == rune('*') || character == rune('/') || character == rune('%') ||
+		character == rune('&') || character == rune('|') || character == rune('^') || character == rune('~')
 }
 
 // isComparison tells us if the passed character is a comparison.
@@ -359,7 +608,7 @@ func isComparison(character rune) bool {
 
 // isCompound tells us if the passed character is a compound.
 func isCompound(character rune) bool {
-	return character == rune('.') || character == rune(',') || character == rune('\'') || character == rune('"') || character == rune(';') || character == rune(':')
+	return character == rune('.') || character == rune(',') || character == rune('\'') || character == rune('"') || character == rune(';') || character == rune(':') || character == rune('?')
 }
 
 // isBrace tells us if the passed character is a brace.
@@ -423,3 +672,13 @@ func (scanner *Scanner) peekCharacter() rune {
 
 	return scanner.source[scanner.readPosition]
 }
+
+// peekCharacterAfter looks at the character following the next upcoming
+// character.
+func (scanner *Scanner) peekCharacterAfter() rune {
+	if scanner.readPosition+1 >= len(scanner.source) {
+		return rune(0)
+	}
+
+	return scanner.source[scanner.readPosition+1]
+}
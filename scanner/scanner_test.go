@@ -14,7 +14,8 @@ func TestScanTokens(t *testing.T) {
 			expectedLexeme string
 		}
 	}{
-		`( ) [ ] { } , . - + ; * % ? : > < >= <= ! != = == "hello world" 42 3.14 6.67428e-11 foo foobar hello1 true false class trait use whilefoo こんにちは 世界 += -= *= /= import from as .. index++ index--`,
+		`( ) [ ] { } , . - + ; * % ? : > < >= <= ! != = == "hello world" 42 3.14 6.67428e-11 foo foobar hello1 true false class trait use whilefoo こんにちは 世界 += -= *= /= import from as .. index++ index-- try catch finally throw => ... ?. ?? ** **= %= & &= | |= ^ ^= ~ ~/ ~/= << <<= >> >>= let const yield match enum static this.#secret # comment
+@memoize`,
 		[]struct {
 			expectedType   token.Type
 			expectedLexeme string
@@ -69,6 +70,41 @@ func TestScanTokens(t *testing.T) {
 			{token.PLUSPLUS, "++"},
 			{token.IDENTIFIER, "index"},
 			{token.MINUSMINUS, "--"},
+			{token.TRY, "try"},
+			{token.CATCH, "catch"},
+			{token.FINALLY, "finally"},
+			{token.THROW, "throw"},
+			{token.ARROW, "=>"},
+			{token.ELLIPSIS, "..."},
+			{token.QUESTIONDOT, "?."},
+			{token.QUESTIONQUESTION, "??"},
+			{token.STARSTAR, "**"},
+			{token.STARSTAREQUAL, "**="},
+			{token.PERCENTEQUAL, "%="},
+			{token.AMPERSAND, "&"},
+			{token.AMPERSANDEQUAL, "&="},
+			{token.PIPE, "|"},
+			{token.PIPEEQUAL, "|="},
+			{token.CARET, "^"},
+			{token.CARETEQUAL, "^="},
+			{token.TILDE, "~"},
+			{token.TILDESLASH, "~/"},
+			{token.TILDESLASHEQUAL, "~/="},
+			{token.LESSLESS, "<<"},
+			{token.LESSLESSEQUAL, "<<="},
+			{token.GREATERGREATER, ">>"},
+			{token.GREATERGREATEREQUAL, ">>="},
+			{token.LET, "let"},
+			{token.CONST, "const"},
+			{token.YIELD, "yield"},
+			{token.IDENTIFIER, "match"},
+			{token.ENUM, "enum"},
+			{token.STATIC, "static"},
+			{token.THIS, "this"},
+			{token.DOT, "."},
+			{token.IDENTIFIER, "#secret"},
+			{token.AT, "@"},
+			{token.IDENTIFIER, "memoize"},
 			{token.EOF, ""},
 		},
 	}
@@ -87,3 +123,174 @@ func TestScanTokens(t *testing.T) {
 		}
 	}
 }
+
+func TestScanInterpolatedString(t *testing.T) {
+	input := `"Hello ${user.name}, you have ${count + 1} items" "escaped \${count}"`
+
+	expected := []token.Fragment{
+		{Value: "Hello "},
+		{Value: "user.name", Expression: true, Line: 1, Column: 10},
+		{Value: ", you have "},
+		{Value: "count + 1", Expression: true, Line: 1, Column: 33},
+		{Value: " items"},
+	}
+
+	scanner := New(input, "test.ghost")
+	interpolation := scanner.ScanToken()
+
+	if interpolation.Type != token.INTERPOLATION {
+		t.Fatalf("token type is wrong. expected=%q, got=%q", token.INTERPOLATION, interpolation.Type)
+	}
+
+	fragments, ok := interpolation.Literal.([]token.Fragment)
+
+	if !ok {
+		t.Fatalf("token literal is not []token.Fragment. got=%T", interpolation.Literal)
+	}
+
+	if len(fragments) != len(expected) {
+		t.Fatalf("wrong number of fragments. expected=%d, got=%d", len(expected), len(fragments))
+	}
+
+	for index, fragment := range expected {
+		if fragments[index] != fragment {
+			t.Fatalf("fragment %d is wrong. expected=%+v, got=%+v", index, fragment, fragments[index])
+		}
+	}
+
+	escaped := scanner.ScanToken()
+
+	if escaped.Type != token.STRING || escaped.Literal != "escaped ${count}" {
+		t.Fatalf("escaped interpolation is wrong. got=%s", escaped.String())
+	}
+}
+
+func TestScanUnterminatedInterpolation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`x = "abc ${1 + 2"`, `1 + 2"`},
+		{`x = "abc ${"`, `"`},
+		{`x = "abc ${count`, `count`},
+	}
+
+	for _, tt := range tests {
+		scanner := New(tt.input, "test.ghost")
+
+		scanner.ScanToken()
+		scanner.ScanToken()
+
+		interpolation := scanner.ScanToken()
+		fragments, ok := interpolation.Literal.([]token.Fragment)
+
+		if !ok {
+			t.Fatalf("token literal of %q is not []token.Fragment. got=%T", tt.input, interpolation.Literal)
+		}
+
+		fragment := fragments[len(fragments)-1]
+
+		if !fragment.Unterminated || fragment.Value != tt.expected {
+			t.Errorf("fragment of %q is wrong. expected unterminated %q, got=%+v", tt.input, tt.expected, fragment)
+		}
+
+		if tok := scanner.ScanToken(); tok.Type != token.EOF {
+			t.Errorf("token after %q is wrong. expected=%q, got=%q", tt.input, token.EOF, tok.Type)
+		}
+	}
+}
+
+func TestTokenPositions(t *testing.T) {
+	input := "total = 12.5 + \"hi\"\n\tlines = \"a\nb\""
+
+	expected := []struct {
+		lexeme    string
+		line      int
+		column    int
+		endLine   int
+		endColumn int
+	}{
+		{"total", 1, 1, 1, 6},
+		{"=", 1, 7, 1, 8},
+		{"12.5", 1, 9, 1, 13},
+		{"+", 1, 14, 1, 15},
+		{"hi", 1, 16, 1, 20},
+		{"lines", 2, 2, 2, 7},
+		{"=", 2, 8, 2, 9},
+		{"a\nb", 2, 10, 3, 3},
+	}
+
+	scanner := New(input, "test.ghost")
+
+	for _, tt := range expected {
+		tok := scanner.ScanToken()
+
+		if tok.Lexeme != tt.lexeme {
+			t.Fatalf("lexeme is wrong. expected=%q, got=%q", tt.lexeme, tok.Lexeme)
+		}
+
+		if tok.Line != tt.line || tok.Column != tt.column || tok.EndLine != tt.endLine || tok.EndColumn != tt.endColumn {
+			t.Errorf("position of %q is wrong. expected=%d:%d-%d:%d, got=%d:%d-%d:%d", tt.lexeme, tt.line, tt.column, tt.endLine, tt.endColumn, tok.Line, tok.Column, tok.EndLine, tok.EndColumn)
+		}
+	}
+}
+
+func TestComments(t *testing.T) {
+	input := "// greeting\nx = 1 # one\n/* a\nb */y"
+
+	scanner := New(input, "test.ghost")
+
+	for _, expected := range []string{"x", "=", "1", "y"} {
+		if tok := scanner.ScanToken(); tok.Lexeme != expected {
+			t.Fatalf("lexeme is wrong. expected=%q, got=%q", expected, tok.Lexeme)
+		}
+	}
+
+	expected := []struct {
+		lexeme    string
+		line      int
+		column    int
+		endLine   int
+		endColumn int
+	}{
+		{"// greeting", 1, 1, 1, 12},
+		{"# one", 2, 7, 2, 12},
+		{"/* a\nb */", 3, 1, 4, 5},
+	}
+
+	comments := scanner.Comments()
+
+	if len(comments) != len(expected) {
+		t.Fatalf("wrong number of comments. expected=%d, got=%d", len(expected), len(comments))
+	}
+
+	for index, tt := range expected {
+		comment := comments[index]
+
+		if comment.Type != token.COMMENT || comment.Lexeme != tt.lexeme {
+			t.Fatalf("comment is wrong. expected=%q, got=%s", tt.lexeme, comment.String())
+		}
+
+		if comment.Line != tt.line || comment.Column != tt.column || comment.EndLine != tt.endLine || comment.EndColumn != tt.endColumn {
+			t.Errorf("position of %q is wrong. expected=%d:%d-%d:%d, got=%d:%d-%d:%d", tt.lexeme, tt.line, tt.column, tt.endLine, tt.endColumn, comment.Line, comment.Column, comment.EndLine, comment.EndColumn)
+		}
+	}
+}
+
+func TestSourceLines(t *testing.T) {
+	scanner := New("first\r\nsecond\nthird", "test.ghost")
+
+	tests := map[int]string{0: "", 1: "first", 2: "second", 3: "third", 4: ""}
+
+	for number, expected := range tests {
+		if line := scanner.Line(number); line != expected {
+			t.Errorf("line %d is wrong. expected=%q, got=%q", number, expected, line)
+		}
+	}
+
+	fragment := NewAt("count + 1", "test.ghost", 7, 12)
+
+	if line := fragment.Line(7); line != "count + 1" {
+		t.Errorf("fragment line is wrong. got=%q", line)
+	}
+}
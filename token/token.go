@@ -13,6 +13,19 @@ type Token struct {
 	Line    int         // Line of occurance
 	Column  int         // Column of occurance on line
 	File    string      // File of occurance
+
+	EndLine   int // Line the token ends on
+	EndColumn int // Column just past the end of the token
+}
+
+// Fragment is a piece of an interpolated string literal. Expression fragments
+// contain the source of an embedded ${...} expression and where it begins.
+type Fragment struct {
+	Value        string // Literal text or expression source
+	Expression   bool   // Is this fragment an embedded expression?
+	Unterminated bool   // Did the file end before the closing "}"?
+	Line         int    // Line the fragment begins on
+	Column       int    // Column the fragment begins on
 }
 
 func (token *Token) String() string {
@@ -21,6 +34,7 @@ func (token *Token) String() string {
 
 const (
 	// single-character tokens
+	AT           = "@"
 	COLON        = ":"
 	COMMA        = ","
 	LEFTBRACE    = "{"
@@ -36,58 +50,89 @@ const (
 	SLASH        = "/"
 	STAR         = "*"
 	PERCENT      = "%"
+	AMPERSAND    = "&"
+	PIPE         = "|"
+	CARET        = "^"
+	TILDE        = "~"
 
 	// one or two character tokens
-	BANG         = "!"
-	BANGEQUAL    = "!="
-	DOT          = "."
-	DOTDOT       = ".."
-	EQUAL        = "="
-	EQUALEQUAL   = "=="
-	GREATER      = ">"
-	GREATEREQUAL = ">="
-	LESS         = "<"
-	LESSEQUAL    = "<="
-	PLUSEQUAL    = "+="
-	PLUSPLUS     = "++"
-	MINUSEQUAL   = "-="
-	MINUSMINUS   = "--"
-	STAREQUAL    = "*="
-	SLASHEQUAL   = "/="
+	ARROW               = "=>"
+	BANG                = "!"
+	BANGEQUAL           = "!="
+	DOT                 = "."
+	DOTDOT              = ".."
+	ELLIPSIS            = "..."
+	EQUAL               = "="
+	EQUALEQUAL          = "=="
+	GREATER             = ">"
+	GREATEREQUAL        = ">="
+	LESS                = "<"
+	LESSEQUAL           = "<="
+	PLUSEQUAL           = "+="
+	PLUSPLUS            = "++"
+	MINUSEQUAL          = "-="
+	MINUSMINUS          = "--"
+	QUESTIONDOT         = "?."
+	QUESTIONQUESTION    = "??"
+	STAREQUAL           = "*="
+	SLASHEQUAL          = "/="
+	AMPERSANDEQUAL      = "&="
+	CARETEQUAL          = "^="
+	GREATERGREATER      = ">>"
+	GREATERGREATEREQUAL = ">>="
+	LESSLESS            = "<<"
+	LESSLESSEQUAL       = "<<="
+	PERCENTEQUAL        = "%="
+	PIPEEQUAL           = "|="
+	STARSTAR            = "**"
+	STARSTAREQUAL       = "**="
+	TILDESLASH          = "~/"
+	TILDESLASHEQUAL     = "~/="
 
 	// literals
-	IDENTIFIER = "IDENTIFIER"
-	STRING     = "STRING"
-	NUMBER     = "NUMBER"
+	COMMENT       = "COMMENT"
+	IDENTIFIER    = "IDENTIFIER"
+	INTERPOLATION = "INTERPOLATION"
+	STRING        = "STRING"
+	NUMBER        = "NUMBER"
 
 	// keywords
 	AND      = "and"
 	AS       = "as"
 	BREAK    = "break"
 	CASE     = "case"
+	CATCH    = "catch"
 	CLASS    = "class"
+	CONST    = "const"
 	CONTINUE = "continue"
 	DEFAULT  = "default"
 	ELSE     = "else"
+	ENUM     = "enum"
 	EXTENDS  = "extends"
 	FALSE    = "false"
+	FINALLY  = "finally"
 	FOR      = "for"
 	FROM     = "from"
 	FUNCTION = "function"
 	IF       = "if"
 	IMPORT   = "import"
 	IN       = "in"
+	LET      = "let"
 	NULL     = "null"
 	OR       = "or"
 	PRINT    = "print"
 	RETURN   = "return"
+	STATIC   = "static"
 	SUPER    = "super"
 	SWITCH   = "switch"
 	THIS     = "this"
+	THROW    = "throw"
 	TRAIT    = "trait"
 	TRUE     = "true"
+	TRY      = "try"
 	USE      = "use"
 	WHILE    = "while"
+	YIELD    = "yield"
 	EOF      = "eof"
 	INVALID  = "__INVALID__"
 )